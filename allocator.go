@@ -0,0 +1,180 @@
+package sequence
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+)
+
+// Allocator hands out the values of a sequence, from its current value
+// through its end, to concurrent callers. Each value is handed out once
+// unless it is released, after which it may be handed out again. Values
+// excluded from iteration are never handed out.
+type Allocator struct {
+	its   *Ints
+	first uint64
+	size  uint64
+	begin uint64
+	next  uint64
+
+	// nfree is the number of released values, read without holding mu
+	// so that allocating does not lock unless values have been released.
+	nfree int64
+	mu    sync.Mutex
+	free  []uint64
+	freed map[uint64]struct{}
+}
+
+// NewAllocator returns an allocator over a copy of a sequence, beginning
+// at its current value. The absolute index of every value of the
+// sequence and the number of values from its start through its end must
+// fit in 64 bits.
+func NewAllocator(its *Ints) (*Allocator, error) {
+	first, last := its.rank(field(its.start)), its.rank(field(its.end))
+	if !last.IsUint64() {
+		return nil, fmt.Errorf("%w: index %v", ErrOverflow, last)
+	}
+
+	size := last.Uint64() - first.Uint64() + 1
+	if size == 0 {
+		return nil, fmt.Errorf("%w: 2^64 values", ErrOverflow)
+	}
+
+	a := &Allocator{
+		its:   its.clone(),
+		first: first.Uint64(),
+		size:  size,
+		begin: new(big.Int).Sub(its.rank(field(its.current)), first).Uint64(),
+		freed: make(map[uint64]struct{}),
+	}
+
+	a.next = a.begin
+
+	return a, nil
+}
+
+// Next returns a value that is not currently handed out. Released values
+// are handed out before new ones. ErrExhausted is returned once every
+// value has been handed out.
+func (a *Allocator) Next() ([]int, error) {
+	for {
+		n, ok := a.pop()
+		if !ok {
+			var err error
+			if n, err = a.reserve(1); err != nil {
+				return nil, err
+			}
+		}
+
+		if f := a.at(n); !a.its.isExcluded(f) {
+			return []int(f), nil
+		}
+	}
+}
+
+// NextN reserves k values that are not currently handed out and returns
+// them in order. The values are reserved in blocks of consecutive values,
+// reserving another block for each value excluded from iteration, so they
+// are consecutive unless values are excluded or other callers reserve
+// values concurrently. Released values are not reused by NextN.
+// ErrExhausted is returned if fewer than k values remain, in which case
+// any values already reserved are released.
+func (a *Allocator) NextN(k int) ([][]int, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("%w: block of %d values", ErrInvalidRange, k)
+	}
+
+	var (
+		vs = make([][]int, 0, k)
+		ns = make([]uint64, 0, k)
+	)
+
+	for len(vs) < k {
+		m := uint64(k - len(vs))
+		n, err := a.reserve(m)
+		if err != nil {
+			a.mu.Lock()
+			a.put(ns...)
+			a.mu.Unlock()
+			return nil, err
+		}
+
+		for i := uint64(0); i < m; i++ {
+			if f := a.at(n + i); !a.its.isExcluded(f) {
+				vs = append(vs, []int(f))
+				ns = append(ns, n+i)
+			}
+		}
+	}
+
+	return vs, nil
+}
+
+// Release returns a value handed out by the allocator so that it may be
+// handed out again. ErrNotAllocated is returned if the value is not
+// currently handed out.
+func (a *Allocator) Release(v []int) error {
+	n, err := a.its.Index(v)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.freed[n]; ok || n < a.begin || atomic.LoadUint64(&a.next) <= n {
+		return fmt.Errorf("%w: %v", ErrNotAllocated, v)
+	}
+
+	a.put(n)
+	return nil
+}
+
+// put adds indices to the released indices. The caller must hold mu.
+func (a *Allocator) put(ns ...uint64) {
+	for _, n := range ns {
+		a.freed[n] = struct{}{}
+	}
+
+	a.free = append(a.free, ns...)
+	atomic.AddInt64(&a.nfree, int64(len(ns)))
+}
+
+// at returns the nth value of the sequence.
+func (a *Allocator) at(n uint64) field {
+	return a.its.unrank64(a.first + n)
+}
+
+// pop removes and returns a released index, if any.
+func (a *Allocator) pop() (uint64, bool) {
+	if atomic.LoadInt64(&a.nfree) == 0 {
+		return 0, false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.free) == 0 {
+		return 0, false
+	}
+
+	n := a.free[len(a.free)-1]
+	a.free = a.free[:len(a.free)-1]
+	delete(a.freed, n)
+	atomic.AddInt64(&a.nfree, -1)
+	return n, true
+}
+
+// reserve returns the first of k consecutive indices that have not been
+// handed out.
+func (a *Allocator) reserve(k uint64) (uint64, error) {
+	for {
+		n := atomic.LoadUint64(&a.next)
+		if a.size-n < k {
+			return 0, fmt.Errorf("%w: %d of %d values remain", ErrExhausted, a.size-n, k)
+		}
+
+		if atomic.CompareAndSwapUint64(&a.next, n, n+k) {
+			return n, nil
+		}
+	}
+}
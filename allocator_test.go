@@ -0,0 +1,183 @@
+package sequence
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestAllocator(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+		WithStart(0, 1, 0),
+		WithEnd(9, 8, 9),
+		WithExcludedValues(2, 3),
+	)
+
+	a, err := NewAllocator(its)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		workers = 8
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		seen    = make(map[int]int)
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; ; j++ {
+				var vs [][]int
+				if i%2 == 0 {
+					v, err := a.Next()
+					if err != nil {
+						return
+					}
+
+					if j%3 == 0 {
+						// Released values are handed out again.
+						if err := a.Release(v); err != nil {
+							t.Error(err)
+						}
+
+						continue
+					}
+
+					vs = append(vs, v)
+				} else {
+					var err error
+					if vs, err = a.NextN(5); err != nil {
+						// Values reserved before running out were
+						// released, so take them one at a time.
+						v, err := a.Next()
+						if err != nil {
+							return
+						}
+
+						vs = [][]int{v}
+					}
+				}
+
+				mu.Lock()
+				for _, v := range vs {
+					seen[100*v[0]+10*v[1]+v[2]]++
+				}
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	for n := 10; n <= 989; n++ {
+		exp := 1
+		if n%10 == 3 {
+			exp = 0
+		}
+
+		if seen[n] != exp {
+			t.Fatalf("\nexpected %03d to be handed out %d times\nreceived %d\n", n, exp, seen[n])
+		}
+	}
+
+	if len(seen) != 980-98 {
+		t.Fatalf("\nexpected %d values\nreceived %d\n", 980-98, len(seen))
+	}
+
+	if _, err := a.Next(); !errors.Is(err, ErrExhausted) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrExhausted, err)
+	}
+}
+
+func TestAllocatorNextN(t *testing.T) {
+	its := New(WithFormat(NewBaseFmt(0, 9)), WithExcludedValues(0, 3, 8))
+	a, err := NewAllocator(its)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The excluded 3 is replaced by 5.
+	vs, err := a.NextN(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, v := range []int{0, 1, 2, 4, 5} {
+		if !equal([]int{v}, vs[i]) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", []int{v}, vs[i])
+		}
+	}
+
+	// Only 6, 7, and 9 remain, so they are released.
+	if vs, err := a.NextN(4); !errors.Is(err, ErrExhausted) || vs != nil {
+		t.Fatalf("\nexpected %v\nreceived %v, %v\n", ErrExhausted, vs, err)
+	}
+
+	seen := make(map[int]bool)
+	for {
+		v, err := a.Next()
+		if err != nil {
+			break
+		}
+
+		seen[v[0]] = true
+	}
+
+	if len(seen) != 3 || !seen[6] || !seen[7] || !seen[9] {
+		t.Fatalf("\nexpected 6, 7, and 9\nreceived %v\n", seen)
+	}
+}
+
+func TestAllocatorOverflow(t *testing.T) {
+	format := make(Format, 64)
+	for i := range format {
+		format[i] = NewBaseFmt(0, 1)
+	}
+
+	if _, err := NewAllocator(New(WithFormat(format...))); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrOverflow, err)
+	}
+}
+
+func TestAllocatorRelease(t *testing.T) {
+	its := New(WithFormat(NewBaseFmt(0, 9)), WithCurrent(5))
+	a, err := NewAllocator(its)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, _ := a.Next()
+	if !equal([]int{5}, v) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", []int{5}, v)
+	}
+
+	if vs, err := a.NextN(5); !errors.Is(err, ErrExhausted) || vs != nil {
+		t.Fatalf("\nexpected %v\nreceived %v, %v\n", ErrExhausted, vs, err)
+	}
+
+	for _, w := range [][]int{{6}, {4}} {
+		if err := a.Release(w); !errors.Is(err, ErrNotAllocated) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", ErrNotAllocated, err)
+		}
+	}
+
+	if err := a.Release(v); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Release(v); !errors.Is(err, ErrNotAllocated) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrNotAllocated, err)
+	}
+
+	if w, err := a.Next(); err != nil || !equal(v, w) {
+		t.Fatalf("\nexpected %v\nreceived %v, %v\n", v, w, err)
+	}
+
+	// The sequence the allocator was made from is independent of it.
+	if its.Next(); !equal([]int{6}, its.Value()) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", []int{6}, its.Value())
+	}
+}
@@ -0,0 +1,180 @@
+package sequence
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Alphabet is an ordered set of characters. The ith character represents
+// the value i of a position.
+type Alphabet struct {
+	runes []rune
+	index map[rune]int
+}
+
+var (
+	// Digits are the decimal digits 0-9.
+	Digits = mustAlphabet("0123456789")
+
+	// Upper are the letters A-Z.
+	Upper = mustAlphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+
+	// Lower are the letters a-z.
+	Lower = mustAlphabet("abcdefghijklmnopqrstuvwxyz")
+
+	// Alphanumeric are the digits 0-9 followed by the letters A-Z.
+	Alphanumeric = mustAlphabet("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+
+	// LowerAlphanumeric are the digits 0-9 followed by the letters a-z.
+	LowerAlphanumeric = mustAlphabet("0123456789abcdefghijklmnopqrstuvwxyz")
+
+	// Crockford32 is Crockford's base 32 alphabet, which omits I, L, O,
+	// and U. Lower case letters are accepted when parsing, as are I and L
+	// for 1 and O for 0.
+	Crockford32 = mustAlias(mustAlphabet("0123456789ABCDEFGHJKMNPQRSTVWXYZ"), map[rune]rune{
+		'a': 'A', 'b': 'B', 'c': 'C', 'd': 'D', 'e': 'E', 'f': 'F', 'g': 'G', 'h': 'H',
+		'j': 'J', 'k': 'K', 'm': 'M', 'n': 'N', 'p': 'P', 'q': 'Q', 'r': 'R', 's': 'S',
+		't': 'T', 'v': 'V', 'w': 'W', 'x': 'X', 'y': 'Y', 'z': 'Z',
+		'I': '1', 'i': '1', 'L': '1', 'l': '1', 'O': '0', 'o': '0',
+	})
+
+	// Base58 is the base 58 alphabet, which omits 0, I, O, and l.
+	Base58 = mustAlphabet("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz")
+
+	// Hex is the base 16 alphabet in upper case.
+	Hex = mustAlphabet("0123456789ABCDEF")
+
+	// HexLower is the base 16 alphabet in lower case.
+	HexLower = mustAlphabet("0123456789abcdef")
+)
+
+// NewAlphabet returns an alphabet of the characters in the given string,
+// in order. The string must be valid UTF-8, non-empty, and may not repeat
+// a character.
+func NewAlphabet(chars string) (Alphabet, error) {
+	if chars == "" {
+		return Alphabet{}, fmt.Errorf("%w: empty alphabet", ErrInvalidRange)
+	}
+
+	a := Alphabet{
+		runes: make([]rune, 0, utf8.RuneCountInString(chars)),
+		index: make(map[rune]int),
+	}
+
+	for i, w := 0, 0; i < len(chars); i += w {
+		var r rune
+		if r, w = utf8.DecodeRuneInString(chars[i:]); r == utf8.RuneError && w == 1 {
+			return Alphabet{}, fmt.Errorf("%w: invalid UTF-8 at offset %d", ErrInvalidRange, i)
+		}
+
+		if _, ok := a.index[r]; ok {
+			return Alphabet{}, fmt.Errorf("%w: repeated character %q at offset %d", ErrInvalidRange, r, i)
+		}
+
+		a.index[r] = len(a.runes)
+		a.runes = append(a.runes, r)
+	}
+
+	return a, nil
+}
+
+// mustAlias returns an alphabet with the given aliases. It panics if the
+// aliases are invalid.
+func mustAlias(a Alphabet, aliases map[rune]rune) Alphabet {
+	b, err := a.Alias(aliases)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+// mustAlphabet returns an alphabet of the given characters. It panics if
+// the characters do not form an alphabet.
+func mustAlphabet(chars string) Alphabet {
+	a, err := NewAlphabet(chars)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+// Alias returns a copy of the alphabet in which each key of aliases is
+// read as the character it maps to. Aliases are accepted when parsing but
+// never written. Each alias must map to a character of the alphabet and
+// may not itself be a character of the alphabet.
+func (a Alphabet) Alias(aliases map[rune]rune) (Alphabet, error) {
+	b := Alphabet{
+		runes: a.runes,
+		index: make(map[rune]int, len(a.index)+len(aliases)),
+	}
+
+	for r, v := range a.index {
+		b.index[r] = v
+	}
+
+	for alias, r := range aliases {
+		if _, ok := b.index[alias]; ok {
+			return Alphabet{}, fmt.Errorf("%w: alias %q is already a character", ErrInvalidRange, alias)
+		}
+
+		v, ok := a.index[r]
+		if !ok {
+			return Alphabet{}, fmt.Errorf("%w: alias %q maps to %q, which is not a character", ErrInvalidRange, alias, r)
+		}
+
+		b.index[alias] = v
+	}
+
+	return b, nil
+}
+
+// Compare returns -1, 0, or 1 as the value of character r precedes,
+// equals, or follows the value of character s. Characters outside the
+// alphabet follow every character of it.
+func (a Alphabet) Compare(r, s rune) int {
+	x, ok := a.Index(r)
+	if !ok {
+		x = len(a.runes)
+	}
+
+	y, ok := a.Index(s)
+	if !ok {
+		y = len(a.runes)
+	}
+
+	switch {
+	case x < y:
+		return -1
+	case y < x:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Index returns the value a character represents.
+func (a Alphabet) Index(r rune) (int, bool) {
+	v, ok := a.index[r]
+	return v, ok
+}
+
+// Len returns the number of characters in the alphabet.
+func (a Alphabet) Len() int {
+	return len(a.runes)
+}
+
+// Rune returns the character representing a value.
+func (a Alphabet) Rune(v int) (rune, bool) {
+	if v < 0 || len(a.runes) <= v {
+		return utf8.RuneError, false
+	}
+
+	return a.runes[v], true
+}
+
+// String returns the characters of the alphabet in order.
+func (a Alphabet) String() string {
+	return string(a.runes)
+}
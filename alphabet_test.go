@@ -0,0 +1,84 @@
+package sequence
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAlphabets(t *testing.T) {
+	greek, err := NewAlphabet("αβγδ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	its := New(
+		WithFormat(NewBaseFmt(0, 31), NewBaseFmt(0, 57), NewBaseFmt(0, 255), NewBaseFmt(0, 3)),
+		WithAlphabets(Crockford32, Base58, HexLower, greek),
+		WithCurrent(17, 57, 255, 3),
+	)
+
+	if exp := "Hzffδ"; its.String() != exp {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, its.String())
+	}
+
+	its.Next()
+	if exp := "J100α"; its.String() != exp {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, its.String())
+	}
+
+	// Crockford aliases parse as their canonical characters.
+	for s, exp := range map[string][]int{"J100α": {18, 0, 0, 0}, "j100α": {18, 0, 0, 0}, "l100α": {1, 0, 0, 0}, "o100α": {0, 0, 0, 0}} {
+		v, err := its.Parse(s)
+		if err != nil || !equal(exp, v) {
+			t.Fatalf("\ngiven %q\nexpected %v\nreceived %v, %v\n", s, exp, v, err)
+		}
+	}
+
+	for _, s := range []string{"U100α", "J000α", "J10Fα", "J100a"} {
+		if _, err := its.Parse(s); !errors.Is(err, ErrSyntax) {
+			t.Fatalf("\ngiven %q\nexpected %v\nreceived %v\n", s, ErrSyntax, err)
+		}
+	}
+
+	tests := []struct {
+		a    Alphabet
+		r, s rune
+		exp  int
+	}{
+		{a: Crockford32, r: 'H', s: 'J', exp: -1},
+		{a: Crockford32, r: 'o', s: '0', exp: 0},
+		{a: Base58, r: 'z', s: 'A', exp: 1},
+		{a: greek, r: 'δ', s: 'α', exp: 1},
+		{a: greek, r: 'a', s: 'δ', exp: 1},
+	}
+
+	for _, test := range tests {
+		if rec := test.a.Compare(test.r, test.s); rec != test.exp {
+			t.Fatalf("\nexpected compare(%q,%q) = %d\nreceived %d\n", test.r, test.s, test.exp, rec)
+		}
+	}
+
+	if _, err := Hex.Alias(map[rune]rune{'g': 'G'}); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+
+	if _, err := Hex.Alias(map[rune]rune{'A': 'a'}); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+
+	for _, chars := range []string{"", "abca", "ab\xff"} {
+		if _, err := NewAlphabet(chars); !errors.Is(err, ErrInvalidRange) {
+			t.Fatalf("\ngiven %q\nexpected %v\nreceived %v\n", chars, ErrInvalidRange, err)
+		}
+	}
+
+	// The replacement character is a character like any other.
+	a, err := NewAlphabet("ab\uFFFD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if i, ok := a.Index('\uFFFD'); !ok || i != 2 {
+		t.Fatalf("\nexpected 2\nreceived %d, %t\n", i, ok)
+	}
+}
@@ -1,37 +1,73 @@
 package sequence
 
-// baseFmt ...
-type baseFmt struct {
+import "fmt"
+
+// BaseFmt is the inclusive range of values a single position may take.
+type BaseFmt struct {
 	min, max int
 }
 
-// newBaseFmt ...
-func newBaseFmt(min, max int) baseFmt {
+// NewBaseFmt returns a base format over the range [min,max]. It panics
+// if the range is invalid.
+func NewBaseFmt(min, max int) BaseFmt {
+	bf, err := TryNewBaseFmt(min, max)
+	if err != nil {
+		panic(err)
+	}
+
+	return bf
+}
+
+// TryNewBaseFmt returns a base format over the range [min,max]. The
+// minimum must be non-negative and no greater than the maximum.
+func TryNewBaseFmt(min, max int) (BaseFmt, error) {
 	if max < min || min < 0 {
-		panic("minimum must be non-negative and less than maximum")
+		return BaseFmt{}, fmt.Errorf("%w: [%d,%d]: minimum must be non-negative and less than maximum", ErrInvalidRange, min, max)
 	}
 
-	return baseFmt{min: min, max: max}
+	return BaseFmt{min: min, max: max}, nil
+}
+
+// Max returns the largest value of the base format.
+func (bf BaseFmt) Max() int {
+	return bf.max
 }
 
-// addWithCarry returns (a+b)mod(max+1) + min.
-func (bf *baseFmt) addWithCarry(a, b int) (int, int) {
+// Min returns the smallest value of the base format.
+func (bf BaseFmt) Min() int {
+	return bf.min
+}
+
+// contains reports whether a value lies within the base format.
+func (bf BaseFmt) contains(v int) bool {
+	return bf.min <= v && v <= bf.max
+}
+
+// addWithCarry returns the sum a+b wrapped into [min,max] along with the
+// number of times the range was wrapped. That is, it returns (r,k) such
+// that a+b = k(max-min+1) + r with min <= r <= max. The carry k is
+// negative if b is sufficiently negative.
+func (bf *BaseFmt) addWithCarry(a, b int) (int, int) {
 	var (
-		c     = a + b
-		carry int
+		n = bf.max - bf.min + 1
+		c = a - bf.min + b
+		k = c / n
+		r = c % n
 	)
 
-	for ; bf.max < c; carry++ {
-		c -= bf.max - bf.min + 1
+	if r < 0 {
+		r += n
+		k--
 	}
 
-	return c, carry
+	return r + bf.min, k
 }
 
-func (bf *baseFmt) subtractWithBorrow(a, b int) (int, int) {
-	if a < b {
-		return b - a + bf.min, 0
-	}
-
-	return bf.addWithCarry(a, bf.max-bf.min-b+1)
+// subtractWithBorrow returns the difference a-b wrapped into [min,max]
+// along with the number of times the range was borrowed from. That is,
+// it returns (r,k) such that a-b = -k(max-min+1) + r with
+// min <= r <= max.
+func (bf *BaseFmt) subtractWithBorrow(a, b int) (int, int) {
+	r, k := bf.addWithCarry(a, -b)
+	return r, -k
 }
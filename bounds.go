@@ -0,0 +1,142 @@
+package sequence
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Policy determines how a sequence behaves when a step would move it
+// past its end or before its start.
+type Policy int
+
+const (
+	// Wrap continues from the start after passing the end and from the
+	// end after passing the start. It is the default policy.
+	Wrap Policy = iota
+
+	// Saturate holds the sequence at its end or start.
+	Saturate
+
+	// Exhaust leaves the sequence unchanged and returns ErrExhausted.
+	Exhaust
+)
+
+// OverflowFunc is called when a step would move a sequence past its end,
+// in which case overflowed is true, or before its start. If it returns an
+// error, the sequence is left unchanged and the error is returned from
+// the step. Otherwise, the policy of the sequence is applied.
+type OverflowFunc func(its *Ints, overflowed bool) error
+
+// WithPolicy sets the policy applied when a step would move the sequence
+// past its end or before its start.
+func WithPolicy(p Policy) Option {
+	return func(its *Ints) {
+		its.policy = p
+	}
+}
+
+// WithOverflowFunc sets a function called when a step would move the
+// sequence past its end or before its start.
+func WithOverflowFunc(fn OverflowFunc) Option {
+	return func(its *Ints) {
+		its.onOverflow = fn
+	}
+}
+
+// step moves the sequence by n values. The move is first attempted
+// position by position on a copy of the current value with fn, which
+// returns the amount carried out of the most significant position. If
+// the result leaves the bounds of the sequence, the move is redone on
+// ordinals and the policy of the sequence is applied. If fn is nil, the
+// move is made on ordinals only. Excluded values are then skipped in the
+// direction of the move.
+func (its *Ints) step(n *big.Int, fn func(f field) int) error {
+	its.overflowed, its.underflowed = false, false
+	f := field(its.current.copy())
+	if fn == nil || fn(f) != 0 || !its.contains(f) {
+		var err error
+		if f, err = its.bound(n); err != nil {
+			return err
+		}
+	}
+
+	if its.exclusions != nil {
+		var err error
+		if f, err = its.settle(f, n.Sign()); err != nil {
+			return err
+		}
+	}
+
+	its.current = current(f)
+	return nil
+}
+
+// bound returns the field n values from the current value, applying the
+// policy of the sequence if it lies beyond the start or end.
+func (its *Ints) bound(n *big.Int) (field, error) {
+	var (
+		first = its.rank(field(its.start))
+		size  = its.size()
+		i     = new(big.Int).Sub(its.rank(field(its.current)), first)
+	)
+
+	i.Add(i, n)
+	overflowed := size.Cmp(i) <= 0
+	if overflowed || i.Sign() < 0 {
+		if err := its.cross(overflowed); err != nil {
+			return nil, err
+		}
+
+		switch its.policy {
+		case Saturate:
+			if overflowed {
+				i.Sub(size, big.NewInt(1))
+			} else {
+				i.SetInt64(0)
+			}
+		default:
+			i.Mod(i, size)
+		}
+	}
+
+	return its.unrank(i.Add(i, first)), nil
+}
+
+// cross records that a step moved past the end of the sequence, if
+// overflowed is true, or before its start. It calls the overflow function
+// and returns ErrExhausted under the Exhaust policy.
+func (its *Ints) cross(overflowed bool) error {
+	if its.onOverflow != nil {
+		if err := its.onOverflow(its, overflowed); err != nil {
+			return err
+		}
+	}
+
+	its.overflowed, its.underflowed = its.overflowed || overflowed, its.underflowed || !overflowed
+	if its.policy == Exhaust {
+		if overflowed {
+			return fmt.Errorf("%w: moved past end %v", ErrExhausted, []int(its.end))
+		}
+
+		return fmt.Errorf("%w: moved before start %v", ErrExhausted, []int(its.start))
+	}
+
+	return nil
+}
+
+// weigh returns the number of values a field moves a sequence by when
+// added to it. Positions absent from the index queue are ignored.
+func (its *Ints) weigh(delta field) *big.Int {
+	var (
+		w = new(big.Int)
+		t = new(big.Int)
+	)
+
+	for i := len(its.indQueue) - 1; 0 <= i; i-- {
+		bf := its.format[its.indQueue[i]]
+		w.Mul(w, t.SetInt64(int64(bf.max-bf.min+1)))
+		w.Add(w, t.SetInt64(int64(delta[its.indQueue[i]])))
+	}
+
+	return w
+}
@@ -0,0 +1,239 @@
+package sequence
+
+import "fmt"
+
+// CheckDigit is a scheme for computing a check character over the text
+// of a value, excluding literal text, so that mistyped text is detected
+// when it is parsed.
+type CheckDigit interface {
+	// Compute returns the check character of a payload. It returns an
+	// error if the payload has a character the scheme does not accept.
+	Compute(payload string) (rune, error)
+}
+
+var (
+	// Luhn is the Luhn algorithm over decimal digits.
+	Luhn CheckDigit = luhnModN{alphabet: Digits, name: "Luhn"}
+
+	// Verhoeff is the Verhoeff algorithm over decimal digits.
+	Verhoeff CheckDigit = verhoeff{}
+
+	// Damm is the Damm algorithm over decimal digits.
+	Damm CheckDigit = damm{}
+
+	// Mod11Radix2 is the ISO 7064 MOD 11-2 pure system over decimal
+	// digits. Its check character is a digit or X.
+	Mod11Radix2 CheckDigit = mod11Radix2{}
+
+	// Mod37Hybrid36 is the ISO 7064 MOD 37,36 hybrid system over the
+	// digits 0-9 and letters A-Z.
+	Mod37Hybrid36 CheckDigit = mod37Hybrid36{}
+)
+
+// WithCheckDigit appends a check character computed by a scheme to the
+// text of each value. Parsing text whose check character does not match
+// returns ErrCheckDigit.
+func WithCheckDigit(cd CheckDigit) Option {
+	return func(its *Ints) {
+		its.checkDigit = cd
+	}
+}
+
+// checkAlphabets returns an error if the check digit scheme of the
+// sequence does not accept a character a position may be written with.
+func (its *Ints) checkAlphabets() error {
+	for i, a := range its.alphabets {
+		min, max := its.format[i].min, its.format[i].max
+		if its.widths[i] != 1 {
+			min, max = 0, a.Len()-1
+		}
+
+		for v := min; v <= max; v++ {
+			r, _ := a.Rune(v)
+			if _, err := its.checkDigit.Compute(string(r)); err != nil {
+				return fmt.Errorf("position %d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkMatches reports whether r is read as the check character c of a
+// scheme. Case matters, since it distinguishes characters of some
+// alphabets. Aliases in the alphabet of a Luhn mod N scheme are read as
+// the characters they map to.
+func checkMatches(cd CheckDigit, r, c rune) bool {
+	if l, ok := cd.(luhnModN); ok {
+		v, ok := l.alphabet.Index(r)
+		w, _ := l.alphabet.Index(c)
+		return ok && v == w
+	}
+
+	return r == c
+}
+
+// LuhnModN returns the Luhn mod N algorithm over the characters of an
+// alphabet, where N is the length of the alphabet.
+func LuhnModN(a Alphabet) CheckDigit {
+	return luhnModN{alphabet: a, name: "Luhn mod " + fmt.Sprint(a.Len())}
+}
+
+// luhnModN is the Luhn mod N algorithm.
+type luhnModN struct {
+	alphabet Alphabet
+	name     string
+}
+
+func (l luhnModN) Compute(payload string) (rune, error) {
+	var (
+		n      = l.alphabet.Len()
+		rs     = []rune(payload)
+		sum    int
+		factor = 2
+	)
+
+	for i := len(rs) - 1; 0 <= i; i-- {
+		v, ok := l.alphabet.Index(rs[i])
+		if !ok {
+			return 0, unaccepted(l.name, rs[i])
+		}
+
+		v *= factor
+		sum += v/n + v%n
+		factor = 3 - factor
+	}
+
+	r, _ := l.alphabet.Rune((n - sum%n) % n)
+	return r, nil
+}
+
+// verhoeff is the Verhoeff algorithm.
+type verhoeff struct{}
+
+var (
+	verhoeffD = [10][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+
+	verhoeffP = [8][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+
+	verhoeffInv = [10]int{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+)
+
+func (verhoeff) Compute(payload string) (rune, error) {
+	var (
+		rs = []rune(payload)
+		c  int
+	)
+
+	for i := len(rs) - 1; 0 <= i; i-- {
+		v, ok := Digits.Index(rs[i])
+		if !ok {
+			return 0, unaccepted("Verhoeff", rs[i])
+		}
+
+		c = verhoeffD[c][verhoeffP[(len(rs)-i)%8][v]]
+	}
+
+	return rune('0' + verhoeffInv[c]), nil
+}
+
+// damm is the Damm algorithm.
+type damm struct{}
+
+var dammTable = [10][10]int{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+func (damm) Compute(payload string) (rune, error) {
+	var c int
+	for _, r := range payload {
+		v, ok := Digits.Index(r)
+		if !ok {
+			return 0, unaccepted("Damm", r)
+		}
+
+		c = dammTable[c][v]
+	}
+
+	return rune('0' + c), nil
+}
+
+// mod11Radix2 is the ISO 7064 MOD 11-2 pure system.
+type mod11Radix2 struct{}
+
+func (mod11Radix2) Compute(payload string) (rune, error) {
+	var p int
+	for _, r := range payload {
+		v, ok := Digits.Index(r)
+		if !ok {
+			return 0, unaccepted("MOD 11-2", r)
+		}
+
+		p = (p + v) * 2 % 11
+	}
+
+	if c := (12 - p) % 11; c != 10 {
+		return rune('0' + c), nil
+	}
+
+	return 'X', nil
+}
+
+// mod37Hybrid36 is the ISO 7064 MOD 37,36 hybrid system.
+type mod37Hybrid36 struct{}
+
+func (mod37Hybrid36) Compute(payload string) (rune, error) {
+	const m = 36
+	p := m
+	for _, r := range payload {
+		v, ok := Alphanumeric.Index(r)
+		if !ok {
+			return 0, unaccepted("MOD 37,36", r)
+		}
+
+		s := (p + v) % m
+		if s == 0 {
+			s = m
+		}
+
+		p = s * 2 % (m + 1)
+	}
+
+	r, _ := Alphanumeric.Rune((m + 1 - p) % m)
+	return r, nil
+}
+
+// unaccepted returns an error describing a character a check digit
+// scheme does not accept.
+func unaccepted(scheme string, r rune) error {
+	return fmt.Errorf("%w: %q is not accepted by %s", ErrSyntax, r, scheme)
+}
@@ -0,0 +1,126 @@
+package sequence
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCheckDigits(t *testing.T) {
+	tests := []struct {
+		cd      CheckDigit
+		payload string
+		exp     rune
+	}{
+		{cd: Luhn, payload: "7992739871", exp: '3'},
+		{cd: Luhn, payload: "", exp: '0'},
+		{cd: Verhoeff, payload: "236", exp: '3'},
+		{cd: Verhoeff, payload: "12345", exp: '1'},
+		{cd: Damm, payload: "572", exp: '4'},
+		{cd: Mod11Radix2, payload: "000000021825009", exp: '7'},
+		{cd: Mod11Radix2, payload: "000000021694233", exp: 'X'},
+		{cd: LuhnModN(Digits), payload: "7992739871", exp: '3'},
+	}
+
+	for _, test := range tests {
+		if c, err := test.cd.Compute(test.payload); err != nil || c != test.exp {
+			t.Fatalf("\ngiven %q\nexpected %q\nreceived %q, %v\n", test.payload, test.exp, c, err)
+		}
+	}
+
+	// Every scheme detects a single mistyped character.
+	schemes := []struct {
+		cd       CheckDigit
+		alphabet Alphabet
+	}{
+		{cd: Luhn, alphabet: Digits},
+		{cd: Verhoeff, alphabet: Digits},
+		{cd: Damm, alphabet: Digits},
+		{cd: Mod11Radix2, alphabet: Digits},
+		{cd: Mod37Hybrid36, alphabet: Alphanumeric},
+		{cd: LuhnModN(Crockford32), alphabet: Crockford32},
+	}
+
+	for _, scheme := range schemes {
+		its := New(
+			WithFormat(NewBaseFmt(0, scheme.alphabet.Len()-1), NewBaseFmt(0, scheme.alphabet.Len()-1)),
+			WithAlphabets(scheme.alphabet, scheme.alphabet),
+			WithCheckDigit(scheme.cd),
+			WithCurrent(3, 7),
+		)
+
+		s := its.String()
+		if v, err := its.Parse(s); err != nil || !equal([]int{3, 7}, v) {
+			t.Fatalf("\ngiven %q\nexpected %v\nreceived %v, %v\n", s, []int{3, 7}, v, err)
+		}
+
+		for v := 0; v < scheme.alphabet.Len(); v++ {
+			if v == 7 {
+				continue
+			}
+
+			r, _ := scheme.alphabet.Rune(v)
+			typo := s[:1] + string(r) + s[2:]
+			_, err := its.Parse(typo)
+			var pe *ParseError
+			if !errors.Is(err, ErrCheckDigit) || !errors.As(err, &pe) || pe.Offset != 2 {
+				t.Fatalf("\ngiven %q mistyped from %q\nexpected %v\nreceived %v\n", typo, s, ErrCheckDigit, err)
+			}
+		}
+	}
+
+	if _, err := TryNew(WithFormat(NewBaseFmt(0, 35)), WithAlphabets(Alphanumeric), WithCheckDigit(Luhn)); !errors.Is(err, ErrSyntax) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrSyntax, err)
+	}
+}
+
+func TestCheckDigitCase(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(0, 57), NewBaseFmt(0, 57)),
+		WithAlphabets(Base58, Base58),
+		WithCheckDigit(LuhnModN(Base58)),
+		WithCurrent(0, 1),
+	)
+
+	// Case distinguishes characters of Base58, so a check character of
+	// the wrong case is mistyped.
+	s := its.String()
+	if exp := "12y"; s != exp {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, s)
+	}
+
+	if _, err := its.Parse("12Y"); !errors.Is(err, ErrCheckDigit) {
+		t.Fatalf("\ngiven %q\nexpected %v\nreceived %v\n", "12Y", ErrCheckDigit, err)
+	}
+
+	// Aliases of the alphabet are read as the characters they map to.
+	its = New(
+		WithFormat(NewBaseFmt(0, 31), NewBaseFmt(0, 31)),
+		WithAlphabets(Crockford32, Crockford32),
+		WithCheckDigit(LuhnModN(Crockford32)),
+		WithCurrent(3, 7),
+	)
+
+	s = strings.ToLower(its.String())
+	if v, err := its.Parse(s); err != nil || !equal([]int{3, 7}, v) {
+		t.Fatalf("\ngiven %q\nexpected %v\nreceived %v, %v\n", s, []int{3, 7}, v, err)
+	}
+}
+
+func TestCheckDigitPattern(t *testing.T) {
+	p, err := ParsePattern("SN-99-99")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	its := New(WithPattern(p), WithCurrent(7, 9, 9, 2), WithCheckDigit(Luhn))
+	if exp := "SN-79-921"; its.String() != exp {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, its.String())
+	}
+
+	for _, s := range []string{"SN-79-923", "SN-79-92"} {
+		if _, err := its.Parse(s); err == nil {
+			t.Fatalf("\ngiven %q\nexpected an error\n", s)
+		}
+	}
+}
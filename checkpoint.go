@@ -0,0 +1,210 @@
+package sequence
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"sync"
+)
+
+// Checkpoint hands out the values of a sequence, from its current value
+// through its end, and persists its progress to a store so that it may be
+// resumed after a restart. Before handing out a value it saves a
+// high-water mark a block of values ahead, then hands out values from
+// memory until the mark is reached. A crash may skip the values below the
+// mark that were not handed out, but no value is ever handed out twice.
+// Values excluded from iteration are never handed out.
+type Checkpoint struct {
+	mu    sync.Mutex
+	its   *Ints
+	store Store
+	block *big.Int
+	size  *big.Int
+
+	// next is the index of the next value to hand out and mark is the
+	// index saved as the high-water mark.
+	next *big.Int
+	mark *big.Int
+}
+
+// NewCheckpoint returns a checkpoint over a copy of a sequence that saves
+// a high-water mark every block values. If the store holds a state, the
+// sequence resumes from it; the state must have been saved for a sequence
+// of the same format, order, start, end, and exclusions. Otherwise it
+// begins at the current value of the sequence. Sequences with position
+// bounds or a skip function are not supported, as neither can be saved.
+func NewCheckpoint(its *Ints, store Store, block uint64) (*Checkpoint, error) {
+	switch {
+	case block == 0:
+		return nil, fmt.Errorf("%w: block of %d values", ErrInvalidRange, block)
+	case its.bounds != nil:
+		return nil, fmt.Errorf("%w: checkpoints with position bounds", errors.ErrUnsupported)
+	case its.exclusions != nil && its.exclusions.skip != nil:
+		return nil, fmt.Errorf("%w: checkpoints with a skip function", errors.ErrUnsupported)
+	}
+
+	c := &Checkpoint{
+		its:   its.clone(),
+		store: store,
+		block: new(big.Int).SetUint64(block),
+		size:  its.size(),
+	}
+
+	s, err := store.Load()
+	switch {
+	case errors.Is(err, ErrNoState):
+		if c.next, err = its.BigIndex(its.current); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if err := c.its.match(s); err != nil {
+			return nil, err
+		}
+
+		if s.Exhausted {
+			c.next = new(big.Int).Set(c.size)
+		} else if c.next, err = c.its.BigIndex(s.Current); err != nil {
+			return nil, fmt.Errorf("%w: current %v: %v", ErrStateMismatch, s.Current, err)
+		}
+	}
+
+	c.mark = new(big.Int).Set(c.next)
+	return c, nil
+}
+
+// Flush saves the index of the next value to hand out, so that no values
+// are skipped on resuming. It should be called before a clean shutdown.
+func (c *Checkpoint) Flush() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.save(c.next); err != nil {
+		return err
+	}
+
+	c.mark.Set(c.next)
+	return nil
+}
+
+// Next returns the next value of the sequence. ErrExhausted is returned
+// once every value has been handed out. If the high-water mark cannot be
+// saved, the error is returned and no value is handed out.
+func (c *Checkpoint) Next() ([]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for {
+		if c.next.Cmp(c.size) == 0 {
+			return nil, fmt.Errorf("%w: %v values handed out", ErrExhausted, c.size)
+		}
+
+		if c.next.Cmp(c.mark) == 0 {
+			mark := new(big.Int).Add(c.next, c.block)
+			if c.size.Cmp(mark) < 0 {
+				mark.Set(c.size)
+			}
+
+			if err := c.save(mark); err != nil {
+				return nil, err
+			}
+
+			c.mark = mark
+		}
+
+		f := c.its.unrank(new(big.Int).Add(c.its.rank(field(c.its.start)), c.next))
+		c.next.Add(c.next, big.NewInt(1))
+		if !c.its.isExcluded(f) {
+			return []int(f), nil
+		}
+	}
+}
+
+// save saves the state of the sequence with the nth value as its current
+// value.
+func (c *Checkpoint) save(n *big.Int) error {
+	s := c.its.state()
+	if n.Cmp(c.size) == 0 {
+		s.Exhausted = true
+	} else {
+		s.Current = []int(c.its.unrank(new(big.Int).Add(c.its.rank(field(c.its.start)), n)))
+	}
+
+	return c.store.Save(s)
+}
+
+// state returns the state of the sequence.
+func (its *Ints) state() *State {
+	s := &State{
+		Format:  make([][2]int, 0, its.dims),
+		Order:   []int(its.indQueue.copy()),
+		Start:   []int(its.start.copy()),
+		End:     []int(its.end.copy()),
+		Current: []int(its.current.copy()),
+	}
+
+	for _, bf := range its.format {
+		s.Format = append(s.Format, [2]int{bf.min, bf.max})
+	}
+
+	ex := its.exclusions
+	if ex == nil {
+		return s
+	}
+
+	for index, vs := range ex.values {
+		if s.ExcludedValues == nil {
+			s.ExcludedValues = make(map[int][]int)
+		}
+
+		s.ExcludedValues[index] = slices.Sorted(maps.Keys(vs))
+	}
+
+	for index, rs := range ex.runes {
+		if s.ExcludedChars == nil {
+			s.ExcludedChars = make(map[int]string)
+		}
+
+		s.ExcludedChars[index] = string(slices.Sorted(maps.Keys(rs)))
+	}
+
+	if 0 < len(ex.words) {
+		s.ExcludedWords = slices.Clone(ex.words)
+		slices.Sort(s.ExcludedWords)
+	}
+
+	return s
+}
+
+// match returns ErrStateMismatch if a state was not saved by a sequence
+// of the same format, order, start, end, and exclusions.
+func (its *Ints) match(s *State) error {
+	t := its.state()
+	if len(s.Format) != len(t.Format) {
+		return fmt.Errorf("%w: format %v, expected %v", ErrStateMismatch, s.Format, t.Format)
+	}
+
+	for i := range s.Format {
+		if s.Format[i] != t.Format[i] {
+			return fmt.Errorf("%w: format %v, expected %v", ErrStateMismatch, s.Format, t.Format)
+		}
+	}
+
+	switch {
+	case !slices.Equal(s.Order, t.Order):
+		return fmt.Errorf("%w: order %v, expected %v", ErrStateMismatch, s.Order, t.Order)
+	case !slices.Equal(s.Start, t.Start):
+		return fmt.Errorf("%w: start %v, expected %v", ErrStateMismatch, s.Start, t.Start)
+	case !slices.Equal(s.End, t.End):
+		return fmt.Errorf("%w: end %v, expected %v", ErrStateMismatch, s.End, t.End)
+	case !maps.EqualFunc(s.ExcludedValues, t.ExcludedValues, slices.Equal[[]int]):
+		return fmt.Errorf("%w: excluded values %v, expected %v", ErrStateMismatch, s.ExcludedValues, t.ExcludedValues)
+	case !maps.Equal(s.ExcludedChars, t.ExcludedChars):
+		return fmt.Errorf("%w: excluded characters %q, expected %q", ErrStateMismatch, s.ExcludedChars, t.ExcludedChars)
+	case !slices.Equal(s.ExcludedWords, t.ExcludedWords):
+		return fmt.Errorf("%w: excluded words %q, expected %q", ErrStateMismatch, s.ExcludedWords, t.ExcludedWords)
+	}
+
+	return nil
+}
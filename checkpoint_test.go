@@ -0,0 +1,157 @@
+package sequence
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckpoint(t *testing.T) {
+	newInts := func() *Ints {
+		return New(
+			WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+			WithEnd(1, 9),
+			WithExcludedValues(1, 3),
+		)
+	}
+
+	stores := map[string]Store{
+		"mem":  &MemStore{},
+		"file": NewFileStore(filepath.Join(t.TempDir(), "state.json")),
+	}
+
+	for name, store := range stores {
+		seen := make(map[[2]int]bool)
+		next := func(c *Checkpoint) ([]int, error) {
+			v, err := c.Next()
+			if err == nil {
+				if seen[[2]int{v[0], v[1]}] {
+					t.Fatalf("\n%s: %v handed out twice\n", name, v)
+				}
+
+				seen[[2]int{v[0], v[1]}] = true
+			}
+
+			return v, err
+		}
+
+		// Crash after handing out 00, 01, 02; the mark is at 05.
+		c, err := NewCheckpoint(newInts(), store, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 3; i++ {
+			if _, err := next(c); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		c, err = NewCheckpoint(newInts(), store, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if v, err := next(c); err != nil || !equal(v, []int{0, 5}) {
+			t.Fatalf("\n%s: expected [0 5]\nreceived %v, %v\n", name, v, err)
+		}
+
+		// A flushed checkpoint resumes without skipping.
+		if err := c.Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		c, err = NewCheckpoint(newInts(), store, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if v, err := next(c); err != nil || !equal(v, []int{0, 6}) {
+			t.Fatalf("\n%s: expected [0 6]\nreceived %v, %v\n", name, v, err)
+		}
+
+		for {
+			if _, err = next(c); err != nil {
+				break
+			}
+		}
+
+		if !errors.Is(err, ErrExhausted) {
+			t.Fatalf("\n%s: expected %v\nreceived %v\n", name, ErrExhausted, err)
+		}
+
+		// 00 to 19 less the excluded 03 and 13 and the skipped 04.
+		if len(seen) != 17 {
+			t.Fatalf("\n%s: expected 17 values\nreceived %d\n", name, len(seen))
+		}
+
+		c, err = NewCheckpoint(newInts(), store, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := next(c); !errors.Is(err, ErrExhausted) {
+			t.Fatalf("\n%s: expected %v\nreceived %v\n", name, ErrExhausted, err)
+		}
+
+		others := []*Ints{
+			New(WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9))),
+			New(
+				WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+				WithEnd(1, 9),
+				WithExcludedValues(1, 4),
+			),
+		}
+
+		for _, other := range others {
+			if _, err := NewCheckpoint(other, store, 5); !errors.Is(err, ErrStateMismatch) {
+				t.Fatalf("\n%s: expected %v\nreceived %v\n", name, ErrStateMismatch, err)
+			}
+		}
+	}
+
+	unsupported := []*Ints{
+		New(
+			WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+			WithSkipFunc(func(v []int) bool { return v[0] == v[1] }),
+		),
+		New(
+			WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+			WithBounds(0, func(v []int) (int, int) { return 0, v[1] }),
+		),
+	}
+
+	for _, its := range unsupported {
+		if _, err := NewCheckpoint(its, &MemStore{}, 5); !errors.Is(err, errors.ErrUnsupported) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", errors.ErrUnsupported, err)
+		}
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	fs := NewFileStore(filepath.Join(t.TempDir(), "state.json"))
+	if _, err := fs.Load(); !errors.Is(err, ErrNoState) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrNoState, err)
+	}
+
+	s := &State{
+		Format:  [][2]int{{0, 9}, {1, 6}},
+		Order:   []int{0, 1},
+		Start:   []int{0, 1},
+		End:     []int{9, 6},
+		Current: []int{4, 2},
+	}
+
+	if err := fs.Save(s); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := fs.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Format[1] != s.Format[1] || !equal(r.Order, s.Order) || !equal(r.Current, s.Current) || r.Exhausted {
+		t.Fatalf("\nexpected %v\nreceived %v\n", s, r)
+	}
+}
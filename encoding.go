@@ -0,0 +1,528 @@
+package sequence
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// encodingVersion is the version written by the binary and JSON
+// encodings. Decoding accepts every version up to it.
+const encodingVersion = 1
+
+// jsonInts is the JSON form of a sequence.
+type jsonInts struct {
+	Version     int      `json:"version"`
+	Format      Format   `json:"format"`
+	Order       []int    `json:"order"`
+	Start       []int    `json:"start"`
+	End         []int    `json:"end"`
+	Current     []int    `json:"current"`
+	Overflowed  bool     `json:"overflowed,omitempty"`
+	Underflowed bool     `json:"underflowed,omitempty"`
+	Policy      Policy   `json:"policy,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	Alphabets   []string `json:"alphabets,omitempty"`
+	Stride      int      `json:"stride,omitempty"`
+	FieldStride []int    `json:"fieldStride,omitempty"`
+}
+
+// MarshalText encodes a base format as "[min,max]".
+func (bf BaseFmt) MarshalText() ([]byte, error) {
+	return []byte("[" + strconv.Itoa(bf.min) + "," + strconv.Itoa(bf.max) + "]"), nil
+}
+
+// UnmarshalText decodes a base format written as by MarshalText.
+func (bf *BaseFmt) UnmarshalText(text []byte) error {
+	s := string(text)
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return fmt.Errorf("%w: base format %q", ErrSyntax, s)
+	}
+
+	bounds := strings.Split(s[1:len(s)-1], ",")
+	if len(bounds) != 2 {
+		return fmt.Errorf("%w: base format %q", ErrSyntax, s)
+	}
+
+	min, err := strconv.Atoi(bounds[0])
+	if err != nil {
+		return fmt.Errorf("%w: base format %q", ErrSyntax, s)
+	}
+
+	max, err := strconv.Atoi(bounds[1])
+	if err != nil {
+		return fmt.Errorf("%w: base format %q", ErrSyntax, s)
+	}
+
+	if *bf, err = TryNewBaseFmt(min, max); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary encodes a base format as a version byte followed by its
+// minimum and the size of its range as unsigned varints.
+func (bf BaseFmt) MarshalBinary() ([]byte, error) {
+	b := []byte{encodingVersion}
+	b = appendUvarint(b, uint64(bf.min))
+	return appendUvarint(b, uint64(bf.max-bf.min)), nil
+}
+
+// UnmarshalBinary decodes a base format written by MarshalBinary.
+func (bf *BaseFmt) UnmarshalBinary(data []byte) error {
+	d, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	f := d.format(1)
+	if err := d.close(); err != nil {
+		return err
+	}
+
+	*bf = f[0]
+	return nil
+}
+
+// MarshalJSON encodes the definition and state of a sequence: its format,
+// order, start, end, current value, overflow flags, policy, pattern, any
+// alphabets differing from those of its pattern, and its stride. Check
+// digit schemes, exclusions, overflow functions, and position bounds are
+// not encoded.
+func (its *Ints) MarshalJSON() ([]byte, error) {
+	js := jsonInts{
+		Version:     encodingVersion,
+		Format:      its.format,
+		Order:       []int(its.indQueue),
+		Start:       []int(its.start),
+		End:         []int(its.end),
+		Current:     []int(its.current),
+		Overflowed:  its.overflowed,
+		Underflowed: its.underflowed,
+		Policy:      its.policy,
+		Alphabets:   its.encodedAlphabets(),
+		FieldStride: its.FieldStride(),
+	}
+
+	if its.pattern != nil {
+		js.Pattern = its.pattern.String()
+	}
+
+	if js.FieldStride == nil && its.stride != 1 {
+		js.Stride = its.stride
+	}
+
+	return json.Marshal(js)
+}
+
+// UnmarshalJSON decodes a sequence written by MarshalJSON into its. The
+// check digit scheme, exclusions, overflow function, and position bounds
+// of its are kept.
+func (its *Ints) UnmarshalJSON(data []byte) error {
+	var js jsonInts
+	if err := json.Unmarshal(data, &js); err != nil {
+		return err
+	}
+
+	if js.Version < 1 || encodingVersion < js.Version {
+		return fmt.Errorf("%w: %d", ErrVersion, js.Version)
+	}
+
+	return its.restore(&js)
+}
+
+// MarshalBinary encodes the same definition and state as MarshalJSON in
+// a compact form: a version byte followed by unsigned varints. Positions
+// are written as offsets from the minimum of their base format.
+func (its *Ints) MarshalBinary() ([]byte, error) {
+	b := []byte{encodingVersion}
+	b = appendUvarint(b, uint64(its.dims))
+	for _, bf := range its.format {
+		b = appendUvarint(b, uint64(bf.min))
+		b = appendUvarint(b, uint64(bf.max-bf.min))
+	}
+
+	b = appendUvarint(b, uint64(len(its.indQueue)))
+	for _, index := range its.indQueue {
+		b = appendUvarint(b, uint64(index))
+	}
+
+	for _, f := range []field{field(its.start), field(its.end), field(its.current)} {
+		b = its.appendField(b, f)
+	}
+
+	var flags byte
+	if its.overflowed {
+		flags |= 1
+	}
+
+	if its.underflowed {
+		flags |= 2
+	}
+
+	b = append(b, flags)
+	b = appendUvarint(b, uint64(its.policy))
+	if its.pattern != nil {
+		b = appendString(b, its.pattern.String())
+	} else {
+		b = appendString(b, "")
+	}
+
+	alphabets := its.encodedAlphabets()
+	b = appendUvarint(b, uint64(len(alphabets)))
+	for _, a := range alphabets {
+		b = appendString(b, a)
+	}
+
+	b = appendVarint(b, int64(its.Stride()))
+	b = appendUvarint(b, uint64(len(its.fieldStride)))
+	for _, d := range its.fieldStride {
+		b = appendVarint(b, int64(d))
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary decodes a sequence written by MarshalBinary into its.
+// The check digit scheme, exclusions, overflow function, and position
+// bounds of its are kept.
+func (its *Ints) UnmarshalBinary(data []byte) error {
+	d, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+
+	js := jsonInts{Format: d.format(int(d.uvarint()))}
+	js.Order = make([]int, 0)
+	for n := d.uvarint(); d.err == nil && 0 < n; n-- {
+		js.Order = append(js.Order, int(d.uvarint()))
+	}
+
+	js.Start = d.field(js.Format)
+	js.End = d.field(js.Format)
+	js.Current = d.field(js.Format)
+	flags := d.byte()
+	js.Overflowed, js.Underflowed = flags&1 != 0, flags&2 != 0
+	js.Policy = Policy(d.uvarint())
+	js.Pattern = d.string()
+	for n := d.uvarint(); d.err == nil && 0 < n; n-- {
+		js.Alphabets = append(js.Alphabets, d.string())
+	}
+
+	js.Stride = d.varint()
+	for n := d.uvarint(); d.err == nil && 0 < n; n-- {
+		js.FieldStride = append(js.FieldStride, d.varint())
+	}
+
+	if err := d.close(); err != nil {
+		return err
+	}
+
+	if 3 < flags {
+		return fmt.Errorf("%w: flags %d", ErrSyntax, flags)
+	}
+
+	return its.restore(&js)
+}
+
+// MarshalValue encodes a value of the sequence as the offset of each
+// position from the minimum of its base format, as unsigned varints.
+func (its *Ints) MarshalValue(v []int) ([]byte, error) {
+	if err := its.validate(v); err != nil {
+		return nil, err
+	}
+
+	return its.appendField([]byte{encodingVersion}, v), nil
+}
+
+// UnmarshalValue decodes a value written by MarshalValue.
+func (its *Ints) UnmarshalValue(data []byte) ([]int, error) {
+	d, err := newDecoder(data)
+	if err != nil {
+		return nil, err
+	}
+
+	v := d.field(its.format)
+	if err := d.close(); err != nil {
+		return nil, err
+	}
+
+	if err := its.validate(v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// appendField appends the offset of each position of a valid field from
+// the minimum of its base format.
+func (its *Ints) appendField(b []byte, f field) []byte {
+	for i, x := range f {
+		b = appendUvarint(b, uint64(x-its.format[i].min))
+	}
+
+	return b
+}
+
+// encodedAlphabets returns the alphabets of the sequence, or nil if they
+// are those of its pattern or, without a pattern, all Digits.
+func (its *Ints) encodedAlphabets() []string {
+	defaults := make([]Alphabet, its.dims)
+	if its.pattern != nil {
+		defaults = its.pattern.Alphabets()
+	} else {
+		for i := range defaults {
+			defaults[i] = Digits
+		}
+	}
+
+	var (
+		alphabets = make([]string, 0, its.dims)
+		differ    bool
+	)
+
+	for i, a := range its.alphabets {
+		alphabets = append(alphabets, a.String())
+		differ = differ || a.String() != defaults[i].String()
+	}
+
+	if !differ {
+		return nil
+	}
+
+	return alphabets
+}
+
+// restore replaces its with the decoded sequence, keeping the check
+// digit scheme, exclusions, overflow function, and position bounds of
+// its.
+func (its *Ints) restore(js *jsonInts) error {
+	opts := []Option{
+		WithFormat(js.Format...),
+		WithOrder(js.Order...),
+		WithStart(js.Start...),
+		WithEnd(js.End...),
+		WithCurrent(js.Current...),
+		WithPolicy(js.Policy),
+		WithStride(js.Stride),
+		func(x *Ints) {
+			x.checkDigit = its.checkDigit
+			x.exclusions = its.exclusions
+			x.onOverflow = its.onOverflow
+			x.bounds = its.bounds
+		},
+	}
+
+	if js.Pattern != "" {
+		p, err := ParsePattern(js.Pattern)
+		if err != nil {
+			return err
+		}
+
+		if !equalFormats(p.Format(), js.Format) {
+			return fmt.Errorf("%w: format %v does not match pattern %q", ErrSyntax, js.Format, js.Pattern)
+		}
+
+		opts[0] = WithPattern(p)
+	}
+
+	if js.FieldStride != nil {
+		opts = append(opts, WithFieldStride(js.FieldStride...))
+	}
+
+	if js.Alphabets != nil {
+		alphabets := make([]Alphabet, 0, len(js.Alphabets))
+		for _, s := range js.Alphabets {
+			a, err := lookupAlphabet(s)
+			if err != nil {
+				return err
+			}
+
+			alphabets = append(alphabets, a)
+		}
+
+		opts = append(opts, WithAlphabets(alphabets...))
+	}
+
+	if js.Policy < Wrap || Exhaust < js.Policy {
+		return fmt.Errorf("%w: policy %d", ErrSyntax, js.Policy)
+	}
+
+	x, err := TryNew(opts...)
+	if err != nil {
+		return err
+	}
+
+	x.overflowed, x.underflowed = js.Overflowed, js.Underflowed
+	*its = *x
+	return nil
+}
+
+// equalFormats reports whether two formats have the same base formats.
+func equalFormats(f, g Format) bool {
+	if len(f) != len(g) {
+		return false
+	}
+
+	for i := range f {
+		if f[i] != g[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookupAlphabet returns the predefined alphabet with the given
+// characters, keeping its aliases, or a new alphabet otherwise.
+func lookupAlphabet(s string) (Alphabet, error) {
+	for _, a := range []Alphabet{Digits, Upper, Lower, Alphanumeric, LowerAlphanumeric, Crockford32, Base58, Hex, HexLower} {
+		if a.String() == s {
+			return a, nil
+		}
+	}
+
+	return NewAlphabet(s)
+}
+
+// appendUvarint appends the varint encoding of v to b.
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+// appendVarint appends the zig-zag varint encoding of v to b.
+func appendVarint(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutVarint(buf[:], v)]...)
+}
+
+// appendString appends the length of s followed by s to b.
+func appendString(b []byte, s string) []byte {
+	return append(appendUvarint(b, uint64(len(s))), s...)
+}
+
+// decoder reads data written by the binary encodings, recording the
+// first error.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+// newDecoder returns a decoder over the data following a supported
+// version byte.
+func newDecoder(data []byte) (*decoder, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty data", ErrSyntax)
+	}
+
+	if data[0] == 0 || encodingVersion < data[0] {
+		return nil, fmt.Errorf("%w: %d", ErrVersion, data[0])
+	}
+
+	return &decoder{data: data[1:]}, nil
+}
+
+// byte reads a single byte.
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+
+	if len(d.data) == 0 {
+		d.err = fmt.Errorf("%w: truncated data", ErrSyntax)
+		return 0
+	}
+
+	c := d.data[0]
+	d.data = d.data[1:]
+	return c
+}
+
+// close returns the first error encountered, or an error if data
+// remains.
+func (d *decoder) close() error {
+	if d.err == nil && len(d.data) != 0 {
+		d.err = fmt.Errorf("%w: %d bytes of trailing data", ErrSyntax, len(d.data))
+	}
+
+	return d.err
+}
+
+// field reads a value of a format.
+func (d *decoder) field(f Format) []int {
+	v := make([]int, 0, len(f))
+	for _, bf := range f {
+		v = append(v, bf.min+int(d.uvarint()))
+	}
+
+	return v
+}
+
+// format reads n base formats.
+func (d *decoder) format(n int) Format {
+	f := make(Format, 0)
+	for ; d.err == nil && 0 < n; n-- {
+		min, size := int(d.uvarint()), int(d.uvarint())
+		bf, err := TryNewBaseFmt(min, min+size)
+		if err != nil && d.err == nil {
+			d.err = err
+		}
+
+		f = append(f, bf)
+	}
+
+	return f
+}
+
+// string reads a string written by appendString.
+func (d *decoder) string() string {
+	n := d.uvarint()
+	if d.err != nil {
+		return ""
+	}
+
+	if uint64(len(d.data)) < n {
+		d.err = fmt.Errorf("%w: truncated data", ErrSyntax)
+		return ""
+	}
+
+	s := string(d.data[:n])
+	d.data = d.data[n:]
+	return s
+}
+
+// uvarint reads an unsigned varint that fits in an int.
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 || uint64(int(^uint(0)>>1)) < v {
+		d.err = fmt.Errorf("%w: malformed varint", ErrSyntax)
+		return 0
+	}
+
+	d.data = d.data[n:]
+	return v
+}
+
+// varint reads a zig-zag varint that fits in an int.
+func (d *decoder) varint() int {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Varint(d.data)
+	if n <= 0 || int64(int(v)) != v {
+		d.err = fmt.Errorf("%w: malformed varint", ErrSyntax)
+		return 0
+	}
+
+	d.data = d.data[n:]
+	return int(v)
+}
@@ -0,0 +1,138 @@
+package sequence
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestEncoding(t *testing.T) {
+	p, err := ParsePattern("INV-AA-{1-6}9")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []*Ints{
+		New(
+			WithFormat(NewBaseFmt(0, 9), NewBaseFmt(1, 6), NewBaseFmt(0, 3)),
+			WithOrder(0, 1),
+			WithStart(2, 1, 3),
+			WithCurrent(5, 4, 3),
+			WithPolicy(Saturate),
+			WithStride(-3),
+		),
+		New(WithPattern(p), WithCurrent(2, 25, 6, 9)),
+		New(
+			WithFormat(NewBaseFmt(0, 31), NewBaseFmt(0, 31)),
+			WithAlphabets(Crockford32, Crockford32),
+			WithFieldStride(1, 2),
+		),
+	}
+
+	codecs := map[string]func(x *Ints) (*Ints, error){
+		"json": func(x *Ints) (*Ints, error) {
+			b, err := json.Marshal(x)
+			if err != nil {
+				return nil, err
+			}
+
+			var y Ints
+			return &y, json.Unmarshal(b, &y)
+		},
+		"binary": func(x *Ints) (*Ints, error) {
+			b, err := x.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+
+			var y Ints
+			return &y, y.UnmarshalBinary(b)
+		},
+		"gob": func(x *Ints) (*Ints, error) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(x); err != nil {
+				return nil, err
+			}
+
+			var y Ints
+			return &y, gob.NewDecoder(&buf).Decode(&y)
+		},
+	}
+
+	for name, codec := range codecs {
+		for _, x := range tests {
+			x.AddN(1)
+			y, err := codec(x)
+			if err != nil {
+				t.Fatalf("\n%s: %v\n", name, err)
+			}
+
+			if x.String() != y.String() || !equal(x.Start(), y.Start()) || !equal(x.End(), y.End()) || !equal(x.Value(), y.Value()) {
+				t.Fatalf("\n%s: expected %v %v %v\nreceived %v %v %v\n", name, x.Start(), x.Value(), x.End(), y.Start(), y.Value(), y.End())
+			}
+
+			x.Next()
+			y.Next()
+			if x.String() != y.String() || x.Overflowed() != y.Overflowed() {
+				t.Fatalf("\n%s: expected %s\nreceived %s\n", name, x, y)
+			}
+		}
+	}
+
+	// Crockford aliases survive decoding.
+	y, _ := codecs["json"](tests[2])
+	if v, err := y.Parse("OI"); err != nil || !equal(v, []int{0, 1}) {
+		t.Fatalf("\nexpected [0 1]\nreceived %v, %v\n", v, err)
+	}
+
+	b, _ := json.Marshal(New(WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9))))
+	if exp := `{"version":1,"format":["[0,9]","[0,9]"],"order":[1,0],"start":[0,0],"end":[9,9],"current":[0,0]}`; string(b) != exp {
+		t.Fatalf("\nexpected %s\nreceived %s\n", exp, b)
+	}
+
+	var x Ints
+	if err := json.Unmarshal([]byte(`{"version":2}`), &x); !errors.Is(err, ErrVersion) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrVersion, err)
+	}
+
+	if err := x.UnmarshalBinary([]byte{1, 1, 0, 9}); !errors.Is(err, ErrSyntax) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrSyntax, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"version":1,"format":["[9,0]"]}`), &x); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+}
+
+func TestEncodeValue(t *testing.T) {
+	its := New(WithFormat(NewBaseFmt(1000, 1999), NewBaseFmt(0, 9)))
+	b, err := its.MarshalValue([]int{1500, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if exp := []byte{1, 0xf4, 0x03, 7}; !bytes.Equal(b, exp) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, b)
+	}
+
+	v, err := its.UnmarshalValue(b)
+	if err != nil || !equal(v, []int{1500, 7}) {
+		t.Fatalf("\nexpected [1500 7]\nreceived %v, %v\n", v, err)
+	}
+
+	if _, err := its.UnmarshalValue([]byte{1, 0xe8, 0x07, 7}); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+
+	var bf BaseFmt
+	if err := bf.UnmarshalText([]byte("[3,12]")); err != nil || bf.Min() != 3 || bf.Max() != 12 {
+		t.Fatalf("\nexpected [3,12]\nreceived %v, %v\n", bf, err)
+	}
+
+	b, _ = NewBaseFmt(3, 12).MarshalBinary()
+	if err := bf.UnmarshalBinary(b); err != nil || bf != NewBaseFmt(3, 12) {
+		t.Fatalf("\nexpected [3,12]\nreceived %v, %v\n", bf, err)
+	}
+}
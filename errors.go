@@ -0,0 +1,79 @@
+package sequence
+
+import (
+	"errors"
+	"strconv"
+)
+
+var (
+	// ErrCheckDigit is returned when the check character of text does
+	// not match the text.
+	ErrCheckDigit = errors.New("check digit mismatch")
+
+	// ErrDimensionMismatch is returned when fields of differing lengths
+	// are used together.
+	ErrDimensionMismatch = errors.New("dimension mismatch")
+
+	// ErrExhausted is returned when a sequence with the Exhaust policy is
+	// moved past its end or before its start.
+	ErrExhausted = errors.New("sequence exhausted")
+
+	// ErrFormatRequired is returned when a sequence is built without a
+	// format.
+	ErrFormatRequired = errors.New("format required")
+
+	// ErrInvalidKey is returned when a permutation key is empty.
+	ErrInvalidKey = errors.New("invalid key")
+
+	// ErrInvalidOrder is returned when an index queue refers to a
+	// position outside the format or refers to a position twice.
+	ErrInvalidOrder = errors.New("invalid order")
+
+	// ErrInvalidPattern is returned when a pattern specification is
+	// malformed.
+	ErrInvalidPattern = errors.New("invalid pattern")
+
+	// ErrInvalidRange is returned when a range is empty or negative, or
+	// a value lies outside its range.
+	ErrInvalidRange = errors.New("invalid range")
+
+	// ErrNoState is returned by a store that has not saved a state.
+	ErrNoState = errors.New("no state saved")
+
+	// ErrNotAllocated is returned when releasing a value that is not
+	// currently handed out by an allocator.
+	ErrNotAllocated = errors.New("value not allocated")
+
+	// ErrOutOfRange is returned when a value or index lies outside the
+	// start and end of a sequence.
+	ErrOutOfRange = errors.New("out of range")
+
+	// ErrOverflow is returned when a result does not fit in 64 bits.
+	ErrOverflow = errors.New("overflow")
+
+	// ErrStateMismatch is returned when a saved state was not saved by
+	// a sequence of the same format, order, start, end, and exclusions.
+	ErrStateMismatch = errors.New("state mismatch")
+
+	// ErrSyntax is returned when text does not have the form of a value.
+	ErrSyntax = errors.New("invalid syntax")
+
+	// ErrVersion is returned when encoded data was written by an
+	// unsupported version of the encoding.
+	ErrVersion = errors.New("unsupported encoding version")
+)
+
+// DimensionError describes a field whose length differs from the
+// number of positions expected.
+type DimensionError struct {
+	Expected, Received int
+}
+
+func (e *DimensionError) Error() string {
+	return ErrDimensionMismatch.Error() + ": expected " + strconv.Itoa(e.Expected) + " positions, received " + strconv.Itoa(e.Received)
+}
+
+// Unwrap returns ErrDimensionMismatch.
+func (e *DimensionError) Unwrap() error {
+	return ErrDimensionMismatch
+}
@@ -0,0 +1,273 @@
+package sequence
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// exclusions are the values a sequence skips over while iterating.
+type exclusions struct {
+	values map[int]map[int]struct{}
+	chars  map[int]string
+	runes  map[int]map[rune]struct{}
+	words  []string
+	skip   func(v []int) bool
+
+	// sig is the index into the index queue of each position, or the
+	// length of the index queue if the position is held.
+	sig []int
+}
+
+// WithExcludedValues excludes values of a position from iteration.
+func WithExcludedValues(index int, values ...int) Option {
+	return func(its *Ints) {
+		ex := its.exclude()
+		if ex.values == nil {
+			ex.values = make(map[int]map[int]struct{})
+		}
+
+		if ex.values[index] == nil {
+			ex.values[index] = make(map[int]struct{})
+		}
+
+		for _, v := range values {
+			ex.values[index][v] = struct{}{}
+		}
+	}
+}
+
+// WithExcludedChars excludes values from iteration that have any of the
+// given characters at a position, as written in its alphabet.
+func WithExcludedChars(index int, chars string) Option {
+	return func(its *Ints) {
+		ex := its.exclude()
+		if ex.chars == nil {
+			ex.chars = make(map[int]string)
+		}
+
+		ex.chars[index] += chars
+	}
+}
+
+// WithExcludedWords excludes values from iteration whose text contains
+// any of the given words, ignoring case. Words matching only the literal
+// text of a pattern are ignored.
+func WithExcludedWords(words ...string) Option {
+	return func(its *Ints) {
+		ex := its.exclude()
+		ex.words = append(ex.words, words...)
+	}
+}
+
+// WithSkipFunc excludes values from iteration for which fn returns true.
+// Unlike the other exclusions, values are passed to fn one at a time, so
+// it should reject few values.
+func WithSkipFunc(fn func(v []int) bool) Option {
+	return func(its *Ints) {
+		its.exclude().skip = fn
+	}
+}
+
+// exclude returns the exclusions of the sequence, creating them if
+// necessary.
+func (its *Ints) exclude() *exclusions {
+	if its.exclusions == nil {
+		its.exclusions = &exclusions{}
+	}
+
+	return its.exclusions
+}
+
+// initExclusions validates the exclusions of the sequence and resolves
+// excluded characters in the alphabet of their positions.
+func (its *Ints) initExclusions() error {
+	ex := its.exclusions
+	ex.sig = make([]int, its.dims)
+	for i := range ex.sig {
+		ex.sig[i] = len(its.indQueue)
+	}
+
+	for qi, index := range its.indQueue {
+		ex.sig[index] = qi
+	}
+
+	for index := range ex.values {
+		if index < 0 || its.dims <= index {
+			return fmt.Errorf("%w: excluded values at position %d", ErrInvalidRange, index)
+		}
+	}
+
+	ex.runes = make(map[int]map[rune]struct{})
+	for index, chars := range ex.chars {
+		if index < 0 || its.dims <= index {
+			return fmt.Errorf("%w: excluded characters at position %d", ErrInvalidRange, index)
+		}
+
+		ex.runes[index] = make(map[rune]struct{})
+		for _, r := range chars {
+			if _, ok := its.alphabets[index].Index(r); !ok {
+				return fmt.Errorf("%w: excluded character %q is not in alphabet %q", ErrInvalidRange, r, its.alphabets[index])
+			}
+
+			ex.runes[index][r] = struct{}{}
+		}
+	}
+
+	for _, w := range ex.words {
+		if w == "" {
+			return fmt.Errorf("%w: empty excluded word", ErrInvalidRange)
+		}
+	}
+
+	return nil
+}
+
+// isExcluded reports whether a field is excluded from iteration.
+func (its *Ints) isExcluded(f field) bool {
+	if its.exclusions == nil {
+		return false
+	}
+
+	_, ok := its.excluded(f)
+	return ok
+}
+
+// excluded reports whether a field is excluded. If it is, it returns the
+// index into the index queue of the least significant position that must
+// change for the field to no longer be excluded for the same reason. If
+// there are several reasons, the most significant such position is
+// returned. Every field between the given one and the next one differing
+// at that position is excluded as well.
+func (its *Ints) excluded(f field) (int, bool) {
+	var (
+		ex    = its.exclusions
+		pivot = -1
+	)
+
+	for index, vs := range ex.values {
+		if _, ok := vs[f[index]]; ok && pivot < ex.sig[index] {
+			pivot = ex.sig[index]
+		}
+	}
+
+	if 0 < len(ex.runes) || 0 < len(ex.words) {
+		var (
+			spans = make([][2]int, its.dims)
+			text  = its.render(f, spans)
+		)
+
+		for index, rs := range ex.runes {
+			for _, r := range text[spans[index][0]:spans[index][1]] {
+				if _, ok := rs[r]; ok && pivot < ex.sig[index] {
+					pivot = ex.sig[index]
+				}
+			}
+		}
+
+		for i := range text {
+			for _, w := range ex.words {
+				j := i
+				for n := utf8.RuneCountInString(w); 0 < n && j < len(text); n-- {
+					_, size := utf8.DecodeRuneInString(text[j:])
+					j += size
+				}
+
+				if !strings.EqualFold(text[i:j], w) {
+					continue
+				}
+
+				// The least significant position overlapping the match.
+				p := -1
+				for index, span := range spans {
+					if span[0] < j && i < span[1] && (p < 0 || ex.sig[index] < p) {
+						p = ex.sig[index]
+					}
+				}
+
+				if pivot < p {
+					pivot = p
+				}
+			}
+		}
+	}
+
+	if pivot < 0 && ex.skip != nil && ex.skip([]int(f)) {
+		pivot = 0
+	}
+
+	return pivot, 0 <= pivot
+}
+
+// jump moves a field to the nearest value in direction dir, which is 1
+// or -1, that differs at the pivot position given by its index into the
+// index queue. Less significant positions are set to their minimum if
+// moving forward or their maximum if moving backward. It returns the
+// amount carried out of the most significant position.
+func (its *Ints) jump(f field, pivot, dir int) int {
+	if its.bounds != nil {
+		return its.jumpBounded(f, pivot, dir)
+	}
+
+	for _, index := range its.indQueue[:pivot] {
+		if 0 < dir {
+			f[index] = its.format[index].min
+		} else {
+			f[index] = its.format[index].max
+		}
+	}
+
+	carry := dir
+	for _, index := range its.indQueue[pivot:] {
+		if carry == 0 {
+			break
+		}
+
+		f[index], carry = its.format[index].addWithCarry(f[index], carry)
+	}
+
+	return carry
+}
+
+// settle moves a field in direction dir to the nearest value that is not
+// excluded. Moving past the start or end of the sequence applies its
+// policy; ErrExhausted is returned if every value is excluded.
+func (its *Ints) settle(f field, dir int) (field, error) {
+	if dir == 0 {
+		dir = 1
+	}
+
+	for crossings := 0; ; {
+		pivot, ok := its.excluded(f)
+		if !ok {
+			return f, nil
+		}
+
+		if its.jump(f, pivot, dir) == 0 && its.contains(f) {
+			continue
+		}
+
+		if crossings++; crossings == 2 {
+			return nil, fmt.Errorf("%w: every value is excluded", ErrExhausted)
+		}
+
+		overflowed := 0 < dir
+		if err := its.cross(overflowed); err != nil {
+			return nil, err
+		}
+
+		// Saturating continues from the bound that was crossed in the
+		// opposite direction, while wrapping continues from the other
+		// bound in the same direction.
+		if its.policy == Saturate {
+			dir = -dir
+			overflowed = !overflowed
+		}
+
+		if overflowed {
+			f = field(its.start.copy())
+		} else {
+			f = field(its.end.copy())
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package sequence
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExcludedValues(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+		WithExcludedValues(1, 3, 4),
+		WithExcludedValues(0, 0),
+	)
+
+	if exp := []int{1, 0}; !equal(exp, its.Value()) {
+		t.Fatalf("\nexpected first value %v\nreceived %v\n", exp, its.Value())
+	}
+
+	exp := [][]int{{1, 1}, {1, 2}, {1, 5}}
+	for _, e := range exp {
+		if err := its.Next(); err != nil || !equal(e, its.Value()) {
+			t.Fatalf("\nexpected %v\nreceived %v, %v\n", e, its.Value(), err)
+		}
+	}
+
+	for i := len(exp) - 2; 0 <= i; i-- {
+		if err := its.Prev(); err != nil || !equal(exp[i], its.Value()) {
+			t.Fatalf("\nexpected %v\nreceived %v, %v\n", exp[i], its.Value(), err)
+		}
+	}
+
+	// Moving back from the first allowed value wraps to the last.
+	its.Prev()
+	its.Prev()
+	if exp := []int{9, 9}; !equal(exp, its.Value()) || !its.Underflowed() {
+		t.Fatalf("\nexpected %v with underflow\nreceived %v\n", exp, its.Value())
+	}
+}
+
+func TestExcludedWords(t *testing.T) {
+	p, err := ParsePattern("A-AA")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		calls int
+		its   = New(
+			WithPattern(p),
+			WithExcludedWords("fu", "-Q"),
+			WithExcludedChars(2, "IO"),
+			WithSkipFunc(func(v []int) bool {
+				calls++
+				return v[0] == 25 && v[1] == 25 && v[2] == 25
+			}),
+			WithPolicy(Exhaust),
+		)
+		count = 1
+	)
+
+	for ; its.Next() == nil; count++ {
+		s := its.String()
+		if strings.Contains(s, "FU") || strings.Contains(s, "-Q") || s[3] == 'I' || s[3] == 'O' || s == "Z-ZZ" {
+			t.Fatalf("\nreceived excluded value %q\n", s)
+		}
+	}
+
+	// Any first letter, then any of the 25*24 pairs without Q first or I
+	// or O second other than FU, less Z-ZZ.
+	if exp := 26*(25*24-1) - 1; count != exp {
+		t.Fatalf("\nexpected %d values\nreceived %d\n", exp, count)
+	}
+
+	// Words and characters skip blocks of values without consulting the
+	// skip function for each one.
+	if 26*26*26 <= calls {
+		t.Fatalf("\nexpected fewer than %d calls\nreceived %d\n", 26*26*26, calls)
+	}
+
+	if s := its.String(); s != "Z-ZY" {
+		t.Fatalf("\nexpected %q\nreceived %q\n", "Z-ZY", s)
+	}
+}
+
+func TestExcludedBounds(t *testing.T) {
+	f := WithFormat(NewBaseFmt(0, 9))
+	its := New(f, WithExcludedValues(0, 9, 8), WithPolicy(Saturate), WithCurrent(7))
+	if err := its.Next(); err != nil || !equal([]int{7}, its.Value()) || !its.Overflowed() {
+		t.Fatalf("\nexpected %v with overflow\nreceived %v, %v\n", []int{7}, its.Value(), err)
+	}
+
+	its = New(f, WithExcludedValues(0, 0, 1), WithCurrent(9))
+	if err := its.Next(); err != nil || !equal([]int{2}, its.Value()) || !its.Overflowed() {
+		t.Fatalf("\nexpected %v with overflow\nreceived %v, %v\n", []int{2}, its.Value(), err)
+	}
+
+	if _, err := TryNew(f, WithExcludedValues(0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)); !errors.Is(err, ErrExhausted) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrExhausted, err)
+	}
+
+	if _, err := TryNew(f, WithExcludedChars(0, "A")); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+
+	if _, err := TryNew(f, WithExcludedValues(1, 0)); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+}
@@ -13,22 +13,22 @@ func newField(terms ...int) field {
 }
 
 // compare ...
-func (f field) compare(field field, iq indexQueue) int {
+func (f field) compare(field field, iq indexQueue) (int, error) {
 	if len(f) != len(field) {
-		panic("dimension mismatch")
+		return 0, &DimensionError{Expected: len(f), Received: len(field)}
 	}
 
-	for _, index := range iq {
-		x, y := f[index], field[index]
+	for i := len(iq) - 1; 0 <= i; i-- {
+		x, y := f[iq[i]], field[iq[i]]
 		switch {
 		case x < y:
-			return -1
+			return -1, nil
 		case y < x:
-			return 1
+			return 1, nil
 		}
 	}
 
-	return 0
+	return 0, nil
 }
 
 // copy ...
@@ -49,22 +49,22 @@ func newStart(terms ...int) start {
 }
 
 // compare ...
-func (s start) compare(start start, iq indexQueue) int {
+func (s start) compare(start start, iq indexQueue) (int, error) {
 	if len(s) != len(start) {
-		panic("dimension mismatch")
+		return 0, &DimensionError{Expected: len(s), Received: len(start)}
 	}
 
-	for _, index := range iq {
-		x, y := s[index], start[index]
+	for i := len(iq) - 1; 0 <= i; i-- {
+		x, y := s[iq[i]], start[iq[i]]
 		switch {
 		case x < y:
-			return -1
+			return -1, nil
 		case y < x:
-			return 1
+			return 1, nil
 		}
 	}
 
-	return 0
+	return 0, nil
 }
 
 // copy ...
@@ -85,29 +85,29 @@ func newCurrent(terms ...int) current {
 }
 
 // compare ...
-func (c current) compare(current current, iq indexQueue) int {
+func (c current) compare(current current, iq indexQueue) (int, error) {
 	if len(c) != len(current) {
-		panic("dimension mismatch")
+		return 0, &DimensionError{Expected: len(c), Received: len(current)}
 	}
 
-	for _, index := range iq {
-		x, y := c[index], current[index]
+	for i := len(iq) - 1; 0 <= i; i-- {
+		x, y := c[iq[i]], current[iq[i]]
 		switch {
 		case x < y:
-			return -1
+			return -1, nil
 		case y < x:
-			return 1
+			return 1, nil
 		}
 	}
 
-	return 0
+	return 0, nil
 }
 
 // copy ...
 func (c current) copy() current {
 	cpy := make(current, len(c), cap(c))
 	copy(cpy, c)
-	return c
+	return cpy
 }
 
 // end ...
@@ -121,22 +121,22 @@ func newEnd(terms ...int) end {
 }
 
 // compare ...
-func (e end) compare(end end, iq indexQueue) int {
+func (e end) compare(end end, iq indexQueue) (int, error) {
 	if len(e) != len(end) {
-		panic("dimension mismatch")
+		return 0, &DimensionError{Expected: len(e), Received: len(end)}
 	}
 
-	for _, index := range iq {
-		x, y := e[index], end[index]
+	for i := len(iq) - 1; 0 <= i; i-- {
+		x, y := e[iq[i]], end[iq[i]]
 		switch {
 		case x < y:
-			return -1
+			return -1, nil
 		case y < x:
-			return 1
+			return 1, nil
 		}
 	}
 
-	return 0
+	return 0, nil
 }
 
 // copy ...
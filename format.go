@@ -1,16 +1,17 @@
 package sequence
 
-// format ...
-type format []baseFmt
+// Format is the base format of each position in a sequence.
+type Format []BaseFmt
 
-func newFormat(baseFmts ...baseFmt) format {
-	f := make(format, len(baseFmts))
+// NewFormat returns a format consisting of the given base formats.
+func NewFormat(baseFmts ...BaseFmt) Format {
+	f := make(Format, len(baseFmts))
 	copy(f, baseFmts)
 	return f
 }
 
-func (f format) copy() format {
-	cpy := make(format, len(f), cap(f))
+func (f Format) copy() Format {
+	cpy := make(Format, len(f), cap(f))
 	copy(cpy, f)
 	return cpy
 }
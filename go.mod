@@ -1,5 +1,5 @@
 module github.com/nathangreene3/sequence
 
-go 1.12
+go 1.23
 
 require github.com/nathangreene3/math v0.0.0-20200121045334-ad205a0cbb46
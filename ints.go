@@ -1,41 +1,62 @@
 package sequence
 
-// ints ...
-type ints struct {
+import (
+	"fmt"
+	"math/big"
+)
+
+// Ints is a sequence of integer fields. Each position ranges over its
+// base format and positions are incremented in the order given by the
+// index queue, carrying into the next position on overflow.
+type Ints struct {
 	dims        int
 	start       start
 	current     current
 	end         end
-	format      format
+	format      Format
+	pattern     *Pattern
+	alphabets   []Alphabet
+	widths      []int
+	literals    []string
 	indQueue    indexQueue
 	overflowed  bool
 	underflowed bool
+	policy      Policy
+	onOverflow  OverflowFunc
+	exclusions  *exclusions
+	checkDigit  CheckDigit
+	stride      int
+	fieldStride field
+	bounds      map[int]BoundsFunc
+	lowBound    int
+	prods       []*big.Int
+}
+
+// New returns a sequence configured by the given options. It panics if
+// the options do not describe a valid sequence.
+func New(opts ...Option) *Ints {
+	its, err := TryNew(opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return its
 }
 
-// newInts ...
-func newInts(opts ...interface{}) ints {
-	var its ints
+// TryNew returns a sequence configured by the given options. A format is
+// required. The start, current, and end values must have a position for
+// each base format and each position must lie within its base format.
+// The start must not follow the end and the current value must lie
+// between them.
+func TryNew(opts ...Option) (*Ints, error) {
+	its := &Ints{}
 	for _, opt := range opts {
-		switch t := opt.(type) {
-		case start:
-			its.start = newStart(t...)
-		case current:
-			its.current = newCurrent(t...)
-		case end:
-			its.end = newEnd(t...)
-		case format:
-			its.dims = len(t)
-			its.format = newFormat(t...)
-		case indexQueue:
-			its.indQueue = newOrder(t...)
-		default:
-			panic("invalid option")
-		}
+		opt(its)
 	}
 
 	if its.format == nil {
 		// TODO: Provide a default format allowing ints to be expanded in dimension.
-		panic("format required")
+		return nil, ErrFormatRequired
 	}
 
 	if its.indQueue == nil {
@@ -45,80 +66,349 @@ func newInts(opts ...interface{}) ints {
 		}
 	}
 
+	if !its.indQueue.isValid(its.format) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOrder, []int(its.indQueue))
+	}
+
+	if err := its.initBounds(); err != nil {
+		return nil, err
+	}
+
 	if its.start == nil {
 		its.start = make(start, 0, its.dims)
 		for _, bf := range its.format {
 			its.start = append(its.start, bf.min)
 		}
+
+		its.fill(field(its.start), len(its.indQueue), 1)
 	}
 
 	if its.current == nil {
 		its.current = current(its.start.copy())
 	}
 
-	if its.end == nil {
-		its.end = make(end, 0, its.dims)
-		for _, bf := range its.format {
-			its.end = append(its.end, bf.max)
+	if err := its.layout(); err != nil {
+		return nil, err
+	}
+
+	if err := its.initStride(); err != nil {
+		return nil, err
+	}
+
+	if its.checkDigit != nil {
+		if err := its.checkAlphabets(); err != nil {
+			return nil, err
 		}
 	}
 
-	if !its.indQueue.isValid(its.format) {
-		panic("invalid order")
+	if its.end == nil && len(its.start) == its.dims {
+		// Positions absent from the index queue are held at the start.
+		its.end = end(its.start.copy())
+		its.fill(field(its.end), len(its.indQueue), -1)
 	}
 
-	return its
+	for _, f := range []field{field(its.start), field(its.current), field(its.end)} {
+		if err := its.validate(f); err != nil {
+			return nil, err
+		}
+	}
+
+	if c, _ := field(its.start).compare(field(its.end), its.indQueue); 0 < c || !its.isHeld(field(its.end)) {
+		return nil, fmt.Errorf("%w: start %v and end %v", ErrInvalidRange, []int(its.start), []int(its.end))
+	}
+
+	if !its.contains(field(its.current)) {
+		return nil, fmt.Errorf("%w: current %v", ErrOutOfRange, []int(its.current))
+	}
+
+	if its.exclusions != nil {
+		if err := its.initExclusions(); err != nil {
+			return nil, err
+		}
+
+		f, err := its.settle(field(its.current.copy()), 1)
+		if err != nil {
+			return nil, err
+		}
+
+		its.current = current(f)
+		its.overflowed, its.underflowed = false, false
+	}
+
+	return its, nil
+}
+
+// Add adds delta to the sequence position by position, carrying into
+// more significant positions. Deltas may be negative. Positions absent
+// from the order are left unchanged. The policy of the sequence applies
+// if the result lies beyond its start or end.
+func (its *Ints) Add(delta []int) error {
+	if its.bounds != nil {
+		return errFieldDelta
+	}
+
+	if len(delta) != its.dims {
+		return &DimensionError{Expected: its.dims, Received: len(delta)}
+	}
+
+	return its.step(its.weigh(delta), func(f field) int { return its.add(f, delta) })
+}
+
+// AddN advances the sequence by n values.
+func (its *Ints) AddN(n int) error {
+	if its.bounds != nil {
+		return its.stepBounded(big.NewInt(int64(n)))
+	}
+
+	return its.step(big.NewInt(int64(n)), func(f field) int { return its.addN(f, n) })
+}
+
+// Compare returns -1, 0, or 1 as a is less than, equal to, or greater
+// than b in the order of the sequence.
+func (its *Ints) Compare(a, b []int) (int, error) {
+	if err := its.validate(a); err != nil {
+		return 0, err
+	}
+
+	if err := its.validate(b); err != nil {
+		return 0, err
+	}
+
+	return field(a).compare(field(b), its.indQueue)
+}
+
+// End returns the last value of the sequence.
+func (its *Ints) End() []int {
+	return []int(its.end.copy())
+}
+
+// Next advances the sequence by its stride.
+func (its *Ints) Next() error {
+	return its.increment()
+}
+
+// Overflowed reports whether the most recent step moved past the end of
+// the sequence.
+func (its *Ints) Overflowed() bool {
+	return its.overflowed
+}
+
+// Prev moves the sequence back by its stride.
+func (its *Ints) Prev() error {
+	return its.decrement()
+}
+
+// Reset returns the sequence to its start and clears its overflow and
+// underflow flags.
+func (its *Ints) Reset() {
+	its.current = current(its.start.copy())
+	its.overflowed, its.underflowed = false, false
+}
+
+// Start returns the first value of the sequence.
+func (its *Ints) Start() []int {
+	return []int(its.start.copy())
 }
 
-// increment ...
-func (its *ints) increment() {
+// Split divides the values from the start through the end of the
+// sequence into k disjoint, contiguous sequences that together cover it,
+// in order. Their lengths differ by at most one, longer sequences coming
+// first. Each begins at its start and otherwise shares the format, order,
+// and options of the sequence. Values excluded from iteration are counted
+// when dividing. There must be at least k values, and ErrExhausted is
+// returned if every value of a part is excluded.
+func (its *Ints) Split(k int) ([]*Ints, error) {
+	size := its.size()
+	if k < 1 || size.Cmp(big.NewInt(int64(k))) < 0 {
+		return nil, fmt.Errorf("%w: %d parts of %v values", ErrInvalidRange, k, size)
+	}
+
 	var (
-		lenOrd = len(its.indQueue)
-		carry  = 1
-		count  int
+		q, r  = new(big.Int).QuoRem(size, big.NewInt(int64(k)), new(big.Int))
+		first = its.rank(field(its.start))
+		parts = make([]*Ints, 0, k)
 	)
 
-	for _, index := range its.indQueue {
-		its.current[index], carry = its.format[index].addWithCarry(its.current[index], carry)
-		if its.current[index] == its.format[index].max {
-			if count++; count == lenOrd && 0 < carry {
-				its.overflowed = true
+	for i := 0; i < k; i++ {
+		n := new(big.Int).Set(q)
+		if int64(i) < r.Int64() {
+			n.Add(n, big.NewInt(1))
+		}
+
+		last := new(big.Int).Add(first, n)
+		last.Sub(last, big.NewInt(1))
+
+		part := its.clone()
+		part.start = start(its.unrank(first))
+		part.end = end(its.unrank(last))
+		part.current = current(part.start.copy())
+		part.overflowed, part.underflowed = false, false
+		if part.exclusions != nil {
+			// Begin at the first value of the part not excluded without
+			// calling the overflow function.
+			part.onOverflow = nil
+			f, err := part.settle(field(part.current.copy()), 1)
+			if err != nil {
+				return nil, fmt.Errorf("%w: every value of part %d is excluded", ErrExhausted, i)
 			}
+
+			part.current = current(f)
+
+			part.onOverflow = its.onOverflow
+			part.overflowed, part.underflowed = false, false
 		}
+
+		parts = append(parts, part)
+		first.Add(last, big.NewInt(1))
 	}
+
+	return parts, nil
 }
 
-// decrement ...
-func (its *ints) decrement() {
-	var (
-		lenOrd = len(its.indQueue)
-		borrow = 1
-		count  int
-	)
+// Subtract subtracts delta from the sequence position by position,
+// borrowing from more significant positions. Deltas may be negative.
+// Positions absent from the order are left unchanged. The policy of the
+// sequence applies if the result lies beyond its start or end.
+func (its *Ints) Subtract(delta []int) error {
+	if its.bounds != nil {
+		return errFieldDelta
+	}
+
+	if len(delta) != its.dims {
+		return &DimensionError{Expected: its.dims, Received: len(delta)}
+	}
+
+	return its.step(new(big.Int).Neg(its.weigh(delta)), func(f field) int { return -its.subtract(f, delta) })
+}
+
+// SubtractN moves the sequence back by n values.
+func (its *Ints) SubtractN(n int) error {
+	if its.bounds != nil {
+		return its.stepBounded(new(big.Int).Neg(big.NewInt(int64(n))))
+	}
+
+	return its.step(new(big.Int).Neg(big.NewInt(int64(n))), func(f field) int { return -its.subtractN(f, n) })
+}
+
+// Underflowed reports whether the most recent step moved before the
+// start of the sequence.
+func (its *Ints) Underflowed() bool {
+	return its.underflowed
+}
+
+// Value returns the current value of the sequence.
+func (its *Ints) Value() []int {
+	return []int(its.current.copy())
+}
+
+// increment advances the sequence by its stride.
+func (its *Ints) increment() error {
+	if its.fieldStride != nil {
+		return its.Add(its.fieldStride)
+	}
+
+	return its.AddN(its.stride)
+}
+
+// decrement moves the sequence back by its stride.
+func (its *Ints) decrement() error {
+	if its.fieldStride != nil {
+		return its.Subtract(its.fieldStride)
+	}
 
+	return its.SubtractN(its.stride)
+}
+
+// add adds delta to a field, carrying along the index queue, and returns
+// the amount carried out of the most significant position.
+func (its *Ints) add(f, delta field) int {
+	var carry int
 	for _, index := range its.indQueue {
-		its.current[index], borrow = its.format[index].subtractWithBorrow(its.current[index], borrow)
+		f[index], carry = its.format[index].addWithCarry(f[index], delta[index]+carry)
+	}
 
-		if its.current[index] == its.format[index].min {
-			if count++; count == lenOrd {
-				its.underflowed = true
-			}
+	return carry
+}
+
+// addN adds n to the least significant position of a field, carrying
+// along the index queue, and returns the amount carried out of the most
+// significant position.
+func (its *Ints) addN(f field, n int) int {
+	carry := n
+	for _, index := range its.indQueue {
+		if carry == 0 {
+			break
 		}
+
+		f[index], carry = its.format[index].addWithCarry(f[index], carry)
+	}
+
+	return carry
+}
+
+// nudge moves a field by one value in direction dir, which is 1 or -1,
+// and returns the amount carried out of the most significant position.
+func (its *Ints) nudge(f field, dir int) int {
+	if its.bounds != nil {
+		return its.jumpBounded(f, 0, dir)
+	}
+
+	return its.addN(f, dir)
+}
+
+// subtract subtracts delta from a field, borrowing along the index
+// queue, and returns the amount borrowed from beyond the most significant
+// position.
+func (its *Ints) subtract(f, delta field) int {
+	var borrow int
+	for _, index := range its.indQueue {
+		f[index], borrow = its.format[index].subtractWithBorrow(f[index], delta[index]+borrow)
 	}
+
+	return borrow
 }
 
-// add ...
-func (its *ints) add(c field) {
-	var carry, count int
+// subtractN subtracts n from the least significant position of a field,
+// borrowing along the index queue, and returns the amount borrowed from
+// beyond the most significant position.
+func (its *Ints) subtractN(f field, n int) int {
+	borrow := n
 	for _, index := range its.indQueue {
-		its.current[index], carry = its.format[index].addWithCarry(its.current[index], c[index]+carry)
-		if count++; count == its.dims {
-			its.overflowed = true
+		if borrow == 0 {
+			break
 		}
+
+		f[index], borrow = its.format[index].subtractWithBorrow(f[index], borrow)
 	}
+
+	return borrow
+}
+
+// clone returns a copy of the sequence that may be moved independently.
+func (its *Ints) clone() *Ints {
+	cpy := *its
+	cpy.current = its.current.copy()
+	return &cpy
 }
 
-// subtract ...
-func (its *ints) subtract(c field) {
-	// TODO
+// validate returns an error if a field does not have a position for each
+// base format or a position lies outside its base format.
+func (its *Ints) validate(f field) error {
+	if len(f) != its.dims {
+		return &DimensionError{Expected: its.dims, Received: len(f)}
+	}
+
+	for i, bf := range its.format {
+		if !bf.contains(f[i]) {
+			return fmt.Errorf("%w: position %d has value %d outside [%d,%d]", ErrInvalidRange, i, f[i], bf.min, bf.max)
+		}
+	}
+
+	for index := range its.bounds {
+		if lo, hi := its.limits(index, f); f[index] < lo || hi < f[index] {
+			return fmt.Errorf("%w: position %d has value %d outside [%d,%d]", ErrInvalidRange, index, f[index], lo, hi)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,91 @@
+package sequence
+
+import (
+	"iter"
+	"math/big"
+)
+
+// All returns an iterator over the values from the start through the end
+// of the sequence, with their indices counted from the start. Values
+// excluded from iteration are skipped but counted. The stride and policy
+// of the sequence do not apply and its current value is unchanged.
+// Indices wrap modulo 2^64 in sequences of more values, whose exact
+// positions are given by BigIndex.
+func (its *Ints) All() iter.Seq2[uint64, []int] {
+	return its.walk(field(its.start), field(its.end))
+}
+
+// Backward returns an iterator over the values from the end through the
+// start of the sequence, as All does in reverse.
+func (its *Ints) Backward() iter.Seq2[uint64, []int] {
+	return its.walk(field(its.end), field(its.start))
+}
+
+// Between returns an iterator over the values from a through b, as All
+// does, with indices counted from the start that wrap as they do there.
+// If b precedes a, the values are in reverse. Nothing is yielded if a or b
+// does not lie in the sequence.
+func (its *Ints) Between(a, b []int) iter.Seq2[uint64, []int] {
+	if !its.Contains(a) || !its.Contains(b) {
+		return func(yield func(uint64, []int) bool) {}
+	}
+
+	return its.walk(field(a).copy(), field(b).copy())
+}
+
+// Values returns an iterator over the values from the start through the
+// end of the sequence, as All does without indices.
+func (its *Ints) Values() iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		for _, v := range its.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// walk returns an iterator over the values from a through b, which must
+// lie in the sequence. Indices are truncated to their low 64 bits.
+func (its *Ints) walk(a, b field) iter.Seq2[uint64, []int] {
+	return func(yield func(uint64, []int) bool) {
+		dir := 1
+		if c, _ := a.compare(b, its.indQueue); 0 < c {
+			dir = -1
+		}
+
+		var (
+			first = its.rank(field(its.start))
+			f     = a.copy()
+			n     = new(big.Int).Sub(its.rank(f), first).Uint64()
+		)
+
+		for {
+			if its.exclusions != nil {
+				if pivot, ok := its.excluded(f); ok {
+					if its.jump(f, pivot, dir) != 0 {
+						return
+					}
+
+					if c, _ := f.compare(b, its.indQueue); c == dir {
+						return
+					}
+
+					n = new(big.Int).Sub(its.rank(f), first).Uint64()
+					continue
+				}
+			}
+
+			if !yield(n, []int(f.copy())) {
+				return
+			}
+
+			if c, _ := f.compare(b, its.indQueue); c == 0 {
+				return
+			}
+
+			its.nudge(f, dir)
+			n += uint64(dir)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package sequence
+
+import "testing"
+
+func TestIter(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(0, 9), NewBaseFmt(1, 6), NewBaseFmt(0, 3)),
+		WithOrder(2, 0),
+		WithStart(1, 3, 2),
+		WithEnd(3, 3, 1),
+		WithCurrent(2, 3, 3),
+		WithExcludedValues(2, 0),
+	)
+
+	var (
+		vs [][]int
+		ns []uint64
+	)
+
+	for n, v := range its.All() {
+		vs, ns = append(vs, v), append(ns, n)
+	}
+
+	exp := [][]int{{1, 3, 2}, {1, 3, 3}, {2, 3, 1}, {2, 3, 2}, {2, 3, 3}, {3, 3, 1}}
+	expN := []uint64{0, 1, 3, 4, 5, 7}
+	if len(vs) != len(exp) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, vs)
+	}
+
+	for i := range exp {
+		if !equal(vs[i], exp[i]) || ns[i] != expN[i] {
+			t.Fatalf("\nexpected %d: %v\nreceived %d: %v\n", expN[i], exp[i], ns[i], vs[i])
+		}
+	}
+
+	i := len(exp)
+	for n, v := range its.Backward() {
+		i--
+		if !equal(v, exp[i]) || n != expN[i] {
+			t.Fatalf("\nexpected %d: %v\nreceived %d: %v\n", expN[i], exp[i], n, v)
+		}
+	}
+
+	if i != 0 {
+		t.Fatalf("\nexpected %d values\nreceived %d\n", len(exp), len(exp)-i)
+	}
+
+	vs = vs[:0]
+	for _, v := range its.Between([]int{3, 3, 1}, []int{2, 3, 0}) {
+		vs = append(vs, v)
+	}
+
+	if len(vs) != 4 || !equal(vs[0], []int{3, 3, 1}) || !equal(vs[3], []int{2, 3, 1}) {
+		t.Fatalf("\nexpected [3 3 1] through [2 3 1]\nreceived %v\n", vs)
+	}
+
+	for range its.Between([]int{0, 3, 0}, []int{2, 3, 0}) {
+		t.Fatal("\nexpected no values\n")
+	}
+
+	var count int
+	for v := range its.Values() {
+		if count++; count == 2 {
+			if !equal(v, []int{1, 3, 3}) {
+				t.Fatalf("\nexpected [1 3 3]\nreceived %v\n", v)
+			}
+
+			break
+		}
+	}
+
+	if !equal(its.Value(), []int{2, 3, 3}) {
+		t.Fatalf("\nexpected [2 3 3]\nreceived %v\n", its.Value())
+	}
+}
@@ -0,0 +1,64 @@
+package sequence
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// BigDistance returns the number of steps from a to b. It is negative if
+// b precedes a.
+func (its *Ints) BigDistance(a, b []int) (*big.Int, error) {
+	for _, v := range [][]int{a, b} {
+		if err := its.validate(v); err != nil {
+			return nil, err
+		}
+
+		if !its.isHeld(v) {
+			return nil, fmt.Errorf("%w: %v", ErrOutOfRange, v)
+		}
+	}
+
+	return new(big.Int).Sub(its.rank(b), its.rank(a)), nil
+}
+
+// BigLen returns the number of values from the start through the end of
+// the sequence. Values excluded from iteration are counted.
+func (its *Ints) BigLen() *big.Int {
+	return its.size()
+}
+
+// Contains reports whether a value lies between the start and end of the
+// sequence.
+func (its *Ints) Contains(v []int) bool {
+	return its.validate(v) == nil && its.contains(v)
+}
+
+// Distance returns the number of steps from a to b. It is negative if b
+// precedes a. ErrOverflow is returned if the distance does not fit in 64
+// bits, in which case BigDistance should be used.
+func (its *Ints) Distance(a, b []int) (int64, error) {
+	d, err := its.BigDistance(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	if !d.IsInt64() {
+		return 0, fmt.Errorf("%w: distance %v", ErrOverflow, d)
+	}
+
+	return d.Int64(), nil
+}
+
+// Len returns the number of values from the start through the end of the
+// sequence. Values excluded from iteration are counted. If the length
+// does not fit in 64 bits, math.MaxUint64 is returned with ErrOverflow
+// and BigLen should be used instead.
+func (its *Ints) Len() (uint64, error) {
+	n := its.size()
+	if !n.IsUint64() {
+		return math.MaxUint64, fmt.Errorf("%w: length %v", ErrOverflow, n)
+	}
+
+	return n.Uint64(), nil
+}
@@ -0,0 +1,60 @@
+package sequence
+
+// Option configures an Ints sequence.
+type Option func(its *Ints)
+
+// WithStart sets the first value of the sequence. It defaults to the
+// minimum of each base format.
+func WithStart(terms ...int) Option {
+	return func(its *Ints) {
+		its.start = newStart(terms...)
+	}
+}
+
+// WithCurrent sets the value the sequence begins at. It defaults to the
+// start.
+func WithCurrent(terms ...int) Option {
+	return func(its *Ints) {
+		its.current = newCurrent(terms...)
+	}
+}
+
+// WithEnd sets the last value of the sequence. It defaults to the
+// maximum of each base format.
+func WithEnd(terms ...int) Option {
+	return func(its *Ints) {
+		its.end = newEnd(terms...)
+	}
+}
+
+// WithFormat sets the base format of each position. A format or a
+// pattern is required.
+func WithFormat(baseFmts ...BaseFmt) Option {
+	return func(its *Ints) {
+		its.dims = len(baseFmts)
+		its.format = NewFormat(baseFmts...)
+		its.pattern = nil
+		its.literals = nil
+	}
+}
+
+// WithPattern sets the format of each position from a pattern. A format
+// or a pattern is required.
+func WithPattern(p *Pattern) Option {
+	return func(its *Ints) {
+		its.dims = len(p.format)
+		its.format = p.Format()
+		its.pattern = p
+		its.alphabets = p.Alphabets()
+		its.literals = p.Literals()
+	}
+}
+
+// WithOrder sets the order positions are incremented in, from the least
+// significant index to the most significant. It defaults to the last
+// index through the first.
+func WithOrder(indices ...int) Option {
+	return func(its *Ints) {
+		its.indQueue = newOrder(indices...)
+	}
+}
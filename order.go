@@ -17,7 +17,7 @@ func (iq indexQueue) copy() indexQueue {
 	return cpy
 }
 
-func (iq indexQueue) isValid(f format) bool {
+func (iq indexQueue) isValid(f Format) bool {
 	n := len(f)
 	m := make(map[int]struct{})
 	for _, index := range iq {
@@ -0,0 +1,338 @@
+package sequence
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Pattern describes the positions of a sequence and the literal text
+// between them. It is parsed from a compact specification in which
+//
+//	9        is a digit 0-9
+//	A        is a letter A-Z
+//	a        is a letter a-z
+//	X        is a digit or letter 0-9A-Z
+//	x        is a digit or letter 0-9a-z
+//	[...]    is one of the listed characters, in the order listed, where
+//	         c-d lists the characters c through d
+//	{c-d}    is a character c through d of one of the alphabets above
+//	\c       is the literal character c
+//
+// and any other character is literal. For example, "AA-999-[A-HJ-NP-Z]"
+// is two letters, a dash, three digits, a dash, and a letter other than I
+// or O, and "{0-3}{0-9}{A-Z}" is a digit 0-3, a digit, and a letter.
+type Pattern struct {
+	spec      string
+	format    Format
+	alphabets []Alphabet
+	literals  []string
+}
+
+// PatternError describes a malformed pattern specification.
+type PatternError struct {
+	// Pattern is the specification.
+	Pattern string
+
+	// Offset is the byte offset into the specification at which the
+	// error was found.
+	Offset int
+
+	// Msg describes the error.
+	Msg string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *PatternError) Error() string {
+	return fmt.Sprintf("%s %q at offset %d: %s", ErrInvalidPattern, e.Pattern, e.Offset, e.Msg)
+}
+
+// Is reports whether target is ErrInvalidPattern.
+func (e *PatternError) Is(target error) bool {
+	return target == ErrInvalidPattern
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *PatternError) Unwrap() error {
+	return e.Err
+}
+
+// placeholders are the characters standing for an entire alphabet.
+var placeholders = map[rune]Alphabet{
+	'9': Digits,
+	'A': Upper,
+	'a': Lower,
+	'X': Alphanumeric,
+	'x': LowerAlphanumeric,
+}
+
+// rangeAlphabets are the alphabets searched, in order, for the endpoints
+// of a {c-d} range.
+var rangeAlphabets = []Alphabet{Digits, Upper, Lower, Alphanumeric, LowerAlphanumeric}
+
+// ParsePattern parses a pattern specification.
+func ParsePattern(spec string) (*Pattern, error) {
+	var (
+		p = &Pattern{spec: spec}
+		b strings.Builder
+	)
+
+	for i := 0; i < len(spec); {
+		r, w := utf8.DecodeRuneInString(spec[i:])
+		if r == utf8.RuneError && w <= 1 {
+			return nil, p.errorf(i, "invalid UTF-8")
+		}
+
+		switch r {
+		case '\\':
+			c, cw := utf8.DecodeRuneInString(spec[i+w:])
+			if cw == 0 {
+				return nil, p.errorf(i, "trailing escape")
+			}
+
+			b.WriteRune(c)
+			i += w + cw
+			continue
+		case '[':
+			a, n, err := p.parseClass(i)
+			if err != nil {
+				return nil, err
+			}
+
+			p.push(&b, a, 0, a.Len()-1)
+			i = n
+			continue
+		case '{':
+			a, min, max, n, err := p.parseRange(i)
+			if err != nil {
+				return nil, err
+			}
+
+			p.push(&b, a, min, max)
+			i = n
+			continue
+		case ']', '}':
+			return nil, p.errorf(i, fmt.Sprintf("unexpected %q", r))
+		}
+
+		if a, ok := placeholders[r]; ok {
+			p.push(&b, a, 0, a.Len()-1)
+		} else {
+			b.WriteRune(r)
+		}
+
+		i += w
+	}
+
+	if len(p.format) == 0 {
+		return nil, p.errorf(len(spec), "no positions")
+	}
+
+	p.literals = append(p.literals, b.String())
+	return p, nil
+}
+
+// Alphabets returns the alphabet of each position.
+func (p *Pattern) Alphabets() []Alphabet {
+	cpy := make([]Alphabet, len(p.alphabets))
+	copy(cpy, p.alphabets)
+	return cpy
+}
+
+// End returns the last value of the pattern.
+func (p *Pattern) End() []int {
+	e := make([]int, 0, len(p.format))
+	for _, bf := range p.format {
+		e = append(e, bf.max)
+	}
+
+	return e
+}
+
+// Format returns the format of the pattern.
+func (p *Pattern) Format() Format {
+	return p.format.copy()
+}
+
+// Literals returns the literal text preceding each position followed by
+// the literal text trailing the last position.
+func (p *Pattern) Literals() []string {
+	cpy := make([]string, len(p.literals))
+	copy(cpy, p.literals)
+	return cpy
+}
+
+// Start returns the first value of the pattern.
+func (p *Pattern) Start() []int {
+	s := make([]int, 0, len(p.format))
+	for _, bf := range p.format {
+		s = append(s, bf.min)
+	}
+
+	return s
+}
+
+// String returns the specification the pattern was parsed from.
+func (p *Pattern) String() string {
+	return p.spec
+}
+
+// errorf returns a PatternError at the given offset.
+func (p *Pattern) errorf(offset int, msg string) error {
+	return &PatternError{Pattern: p.spec, Offset: offset, Msg: msg}
+}
+
+// wrapf returns a PatternError at the given offset wrapping err.
+func (p *Pattern) wrapf(offset int, msg string, err error) error {
+	return &PatternError{Pattern: p.spec, Offset: offset, Msg: msg + ": " + err.Error(), Err: err}
+}
+
+// parseClass parses the character class beginning at offset i and
+// returns its alphabet along with the offset following the class.
+func (p *Pattern) parseClass(i int) (Alphabet, int, error) {
+	var (
+		spec  = p.spec
+		chars []rune
+		seen  = make(map[rune]struct{})
+		j     = i + 1
+	)
+
+	// next returns the character at offset j, unescaping it if necessary,
+	// along with whether it was escaped and the offset following it.
+	next := func(j int) (rune, bool, int, error) {
+		r, w := utf8.DecodeRuneInString(spec[j:])
+		switch {
+		case w == 0:
+			return 0, false, 0, p.errorf(i, "unterminated character class")
+		case r == utf8.RuneError && w == 1:
+			return 0, false, 0, p.errorf(j, "invalid UTF-8")
+		case r != '\\':
+			return r, false, j + w, nil
+		}
+
+		c, cw := utf8.DecodeRuneInString(spec[j+w:])
+		if cw == 0 {
+			return 0, false, 0, p.errorf(j, "trailing escape")
+		}
+
+		return c, true, j + w + cw, nil
+	}
+
+	add := func(r rune, offset int) error {
+		if _, ok := seen[r]; ok {
+			return p.errorf(offset, fmt.Sprintf("repeated character %q", r))
+		}
+
+		seen[r] = struct{}{}
+		chars = append(chars, r)
+		return nil
+	}
+
+	for {
+		lo, escaped, k, err := next(j)
+		if err != nil {
+			return Alphabet{}, 0, err
+		}
+
+		if lo == ']' && !escaped {
+			if len(chars) == 0 {
+				return Alphabet{}, 0, p.errorf(i, "empty character class")
+			}
+
+			a, err := NewAlphabet(string(chars))
+			if err != nil {
+				return Alphabet{}, 0, p.wrapf(i, "invalid character class", err)
+			}
+
+			return a, k, nil
+		}
+
+		// A dash followed by the closing bracket is literal.
+		if k < len(spec) && spec[k] == '-' && !strings.HasPrefix(spec[k+1:], "]") {
+			hi, _, m, err := next(k + 1)
+			if err != nil {
+				return Alphabet{}, 0, err
+			}
+
+			if hi < lo {
+				return Alphabet{}, 0, p.errorf(j, fmt.Sprintf("invalid range %q-%q", lo, hi))
+			}
+
+			for r := lo; r <= hi; r++ {
+				if err := add(r, j); err != nil {
+					return Alphabet{}, 0, err
+				}
+			}
+
+			j = m
+			continue
+		}
+
+		if err := add(lo, j); err != nil {
+			return Alphabet{}, 0, err
+		}
+
+		j = k
+	}
+}
+
+// parseRange parses the {c-d} range beginning at offset i and returns
+// the alphabet containing it, the values of c and d, and the offset
+// following the range.
+func (p *Pattern) parseRange(i int) (Alphabet, int, int, int, error) {
+	var (
+		spec   = p.spec
+		j      = i + 1
+		rs     [3]rune
+		starts [3]int
+	)
+
+	for k := range rs {
+		r, w := utf8.DecodeRuneInString(spec[j:])
+		if w == 0 {
+			return Alphabet{}, 0, 0, 0, p.errorf(i, "unterminated range")
+		}
+
+		rs[k], starts[k] = r, j
+		j += w
+	}
+
+	if rs[1] != '-' {
+		return Alphabet{}, 0, 0, 0, p.errorf(starts[1], "expected '-'")
+	}
+
+	if !strings.HasPrefix(spec[j:], "}") {
+		return Alphabet{}, 0, 0, 0, p.errorf(j, "expected '}'")
+	}
+
+	for _, a := range rangeAlphabets {
+		min, ok := a.Index(rs[0])
+		if !ok {
+			continue
+		}
+
+		max, ok := a.Index(rs[2])
+		if !ok {
+			continue
+		}
+
+		if max < min {
+			return Alphabet{}, 0, 0, 0, p.errorf(starts[0], fmt.Sprintf("invalid range %q-%q", rs[0], rs[2]))
+		}
+
+		return a, min, max, j + 1, nil
+	}
+
+	return Alphabet{}, 0, 0, 0, p.errorf(starts[0], fmt.Sprintf("no alphabet contains %q-%q", rs[0], rs[2]))
+}
+
+// push appends a position over [min,max] of an alphabet to the pattern.
+// The literal text written so far precedes it.
+func (p *Pattern) push(b *strings.Builder, a Alphabet, min, max int) {
+	p.format = append(p.format, BaseFmt{min: min, max: max})
+	p.alphabets = append(p.alphabets, a)
+	p.literals = append(p.literals, b.String())
+	b.Reset()
+}
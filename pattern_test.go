@@ -0,0 +1,200 @@
+package sequence
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParsePattern(t *testing.T) {
+	tests := []struct {
+		spec      string
+		format    Format
+		alphabets []string
+		literals  []string
+	}{
+		{
+			spec:      "AA-999-[A-HJ-NP-Z]",
+			format:    Format{{0, 25}, {0, 25}, {0, 9}, {0, 9}, {0, 9}, {0, 23}},
+			alphabets: []string{Upper.String(), Upper.String(), "0123456789", "0123456789", "0123456789", "ABCDEFGHJKLMNPQRSTUVWXYZ"},
+			literals:  []string{"", "", "-", "", "", "-", ""},
+		},
+		{
+			spec:      "{0-3}{0-9}{C-F}",
+			format:    Format{{0, 3}, {0, 9}, {2, 5}},
+			alphabets: []string{"0123456789", "0123456789", Upper.String()},
+			literals:  []string{"", "", "", ""},
+		},
+		{
+			spec:      `LOT\9 9/x.`,
+			format:    Format{{0, 9}, {0, 35}},
+			alphabets: []string{"0123456789", LowerAlphanumeric.String()},
+			literals:  []string{"LOT9 ", "/", "."},
+		},
+		{
+			spec:      `[-\]a-c]`,
+			format:    Format{{0, 4}},
+			alphabets: []string{"-]abc"},
+			literals:  []string{"", ""},
+		},
+		{
+			spec:      "{5-Z}[αβγ]",
+			format:    Format{{5, 35}, {0, 2}},
+			alphabets: []string{Alphanumeric.String(), "αβγ"},
+			literals:  []string{"", "", ""},
+		},
+	}
+
+	for _, test := range tests {
+		p, err := ParsePattern(test.spec)
+		if err != nil {
+			t.Fatalf("\ngiven %q\nreceived %v\n", test.spec, err)
+		}
+
+		if f := p.Format(); len(f) != len(test.format) {
+			t.Fatalf("\ngiven %q\nexpected %v\nreceived %v\n", test.spec, test.format, f)
+		}
+
+		for i, bf := range p.Format() {
+			if bf != test.format[i] || p.Alphabets()[i].String() != test.alphabets[i] {
+				t.Fatalf("\ngiven %q\nexpected %v over %q at position %d\nreceived %v over %q\n", test.spec, test.format[i], test.alphabets[i], i, bf, p.Alphabets()[i])
+			}
+		}
+
+		literals := p.Literals()
+		if len(literals) != len(test.literals) {
+			t.Fatalf("\ngiven %q\nexpected %q\nreceived %q\n", test.spec, test.literals, literals)
+		}
+
+		for i := range literals {
+			if literals[i] != test.literals[i] {
+				t.Fatalf("\ngiven %q\nexpected %q\nreceived %q\n", test.spec, test.literals, literals)
+			}
+		}
+
+		if p.String() != test.spec || !equal(p.Start(), New(WithPattern(p)).Start()) || !equal(p.End(), New(WithPattern(p)).End()) {
+			t.Fatalf("\ngiven %q\nreceived inconsistent pattern %v\n", test.spec, p)
+		}
+	}
+}
+
+func TestParsePatternErrors(t *testing.T) {
+	tests := []struct {
+		spec   string
+		offset int
+	}{
+		{spec: "AA-[A-C", offset: 3},
+		{spec: "AA-[]", offset: 3},
+		{spec: "9[C-A]", offset: 2},
+		{spec: "9[ABA]", offset: 4},
+		{spec: "9]", offset: 1},
+		{spec: "99{0-3", offset: 6},
+		{spec: "{0+3}", offset: 2},
+		{spec: "{0-", offset: 0},
+		{spec: "{3-0}", offset: 1},
+		{spec: "{0-Z}{A-9}", offset: 6},
+		{spec: "{a-Z}", offset: 1},
+		{spec: `99\`, offset: 2},
+		{spec: "--", offset: 2},
+		{spec: "9\xff", offset: 1},
+		{spec: "[\uD7FF-\uE000]", offset: 0},
+	}
+
+	for _, test := range tests {
+		_, err := ParsePattern(test.spec)
+		var pe *PatternError
+		if !errors.Is(err, ErrInvalidPattern) || !errors.As(err, &pe) || pe.Offset != test.offset {
+			t.Fatalf("\ngiven %q\nexpected error at offset %d\nreceived %v\n", test.spec, test.offset, err)
+		}
+	}
+	// Classes that are not valid alphabets report why.
+	if _, err := ParsePattern("[\uD7FF-\uE000]"); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+}
+
+func TestFormatParse(t *testing.T) {
+	p, err := ParsePattern(`#A-{1-3}/[xyz]\9`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	its := New(WithPattern(p))
+	n, _ := its.Len()
+	for i := uint64(0); i < n; i++ {
+		v, _ := its.At(i)
+		s, err := its.Format(v)
+		if err != nil {
+			t.Fatalf("\ngiven %v\nreceived %v\n", v, err)
+		}
+
+		w, err := its.Parse(s)
+		if err != nil || !equal(v, w) {
+			t.Fatalf("\ngiven %q\nexpected %v\nreceived %v, %v\n", s, v, w, err)
+		}
+	}
+
+	if exp := "#A-1/x9"; its.String() != exp {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, its.String())
+	}
+
+	tests := []struct {
+		s      string
+		offset int
+		err    error
+	}{
+		{s: "#B-1/y", offset: 6, err: ErrSyntax},
+		{s: "#B-1/y9.", offset: 6, err: ErrSyntax},
+		{s: "#B_1/y9", offset: 2, err: ErrSyntax},
+		{s: "#b-1/y9", offset: 1, err: ErrSyntax},
+		{s: "#B-0/y9", offset: 3, err: ErrInvalidRange},
+		{s: "#B-", offset: 3, err: ErrSyntax},
+	}
+
+	for _, test := range tests {
+		_, err := its.Parse(test.s)
+		var pe *ParseError
+		if !errors.Is(err, test.err) || !errors.As(err, &pe) || pe.Offset != test.offset {
+			t.Fatalf("\ngiven %q\nexpected %v at offset %d\nreceived %v\n", test.s, test.err, test.offset, err)
+		}
+	}
+}
+
+func TestFormatWidth(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(0, 999), NewBaseFmt(0, 255), NewBaseFmt(0, 1)),
+		WithAlphabets(Digits, mustAlphabet("0123456789abcdef"), mustAlphabet("NY")),
+		WithCurrent(7, 10, 1),
+	)
+
+	if exp := "0070aY"; its.String() != exp {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, its.String())
+	}
+
+	if v, err := its.Parse("999ffN"); err != nil || !equal([]int{999, 255, 0}, v) {
+		t.Fatalf("\nexpected %v\nreceived %v, %v\n", []int{999, 255, 0}, v, err)
+	}
+
+	if _, err := its.Parse("99ffN"); !errors.Is(err, ErrSyntax) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrSyntax, err)
+	}
+
+	if _, err := TryNew(WithFormat(NewBaseFmt(0, 1)), WithAlphabets(mustAlphabet("N"))); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+
+	if _, err := TryNew(WithFormat(NewBaseFmt(0, 1)), WithAlphabets(Digits, Digits)); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrDimensionMismatch, err)
+	}
+	// Widths near the largest int must not overflow.
+	its, err := TryNew(
+		WithFormat(NewBaseFmt(0, math.MaxInt), NewBaseFmt(0, math.MaxInt)),
+		WithAlphabets(Digits, mustAlphabet("01")),
+		WithCurrent(math.MaxInt, 1),
+	)
+
+	if exp := "9223372036854775807" + strings.Repeat("0", 62) + "1"; err != nil || its.String() != exp {
+		t.Fatalf("\nexpected %q\nreceived %v, %v\n", exp, its, err)
+	}
+}
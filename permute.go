@@ -0,0 +1,127 @@
+package sequence
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"math/bits"
+)
+
+// feistelRounds is the number of rounds of the Feistel network.
+const feistelRounds = 10
+
+// Permutation is a keyed, reversible shuffle of the values of a sequence.
+// Indices are mapped to values by a balanced Feistel network over the
+// smallest even number of bits covering the length of the sequence, whose
+// round function is HMAC-SHA256 under the key. Outputs at or beyond the
+// length are fed back through the network until they fall within it, so
+// every value is produced exactly once. Without the key, the order cannot
+// be predicted from other values of the permutation.
+type Permutation struct {
+	its  *Ints
+	key  []byte
+	size uint64
+	half uint
+}
+
+// NewPermutation returns a permutation of a copy of a sequence under a
+// key. The length of the sequence must fit in 64 bits and the key must
+// not be empty.
+func NewPermutation(its *Ints, key []byte) (*Permutation, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w: empty permutation key", ErrInvalidKey)
+	}
+
+	size, err := its.Len()
+	if err != nil {
+		return nil, err
+	}
+
+	half := uint(bits.Len64(size-1)+1) / 2
+	if half == 0 {
+		half = 1
+	}
+
+	p := &Permutation{
+		its:  its.clone(),
+		key:  append([]byte(nil), key...),
+		size: size,
+		half: half,
+	}
+
+	return p, nil
+}
+
+// At returns the nth value of the permutation. Values excluded from
+// iteration are counted and may be returned.
+func (p *Permutation) At(n uint64) ([]int, error) {
+	if p.size <= n {
+		return nil, fmt.Errorf("%w: index %d", ErrOutOfRange, n)
+	}
+
+	mac := hmac.New(sha256.New, p.key)
+	// Cycle-walk until the result lies within the sequence.
+	n = p.encrypt(mac, n)
+	for p.size <= n {
+		n = p.encrypt(mac, n)
+	}
+
+	return p.its.At(n)
+}
+
+// Index returns the position of a value in the permutation.
+func (p *Permutation) Index(v []int) (uint64, error) {
+	n, err := p.its.Index(v)
+	if err != nil {
+		return 0, err
+	}
+
+	mac := hmac.New(sha256.New, p.key)
+	// Cycle-walk until the result lies within the sequence.
+	n = p.decrypt(mac, n)
+	for p.size <= n {
+		n = p.decrypt(mac, n)
+	}
+
+	return n, nil
+}
+
+// Len returns the number of values of the permutation.
+func (p *Permutation) Len() uint64 {
+	return p.size
+}
+
+// encrypt applies the Feistel network to n.
+func (p *Permutation) encrypt(mac hash.Hash, n uint64) uint64 {
+	mask := uint64(1)<<p.half - 1
+	l, r := n>>p.half, n&mask
+	for i := 0; i < feistelRounds; i++ {
+		l, r = r, l^p.round(mac, i, r)&mask
+	}
+
+	return l<<p.half | r
+}
+
+// decrypt applies the inverse of the Feistel network to n.
+func (p *Permutation) decrypt(mac hash.Hash, n uint64) uint64 {
+	mask := uint64(1)<<p.half - 1
+	l, r := n>>p.half, n&mask
+	for i := feistelRounds - 1; 0 <= i; i-- {
+		l, r = r^p.round(mac, i, l)&mask, l
+	}
+
+	return l<<p.half | r
+}
+
+// round returns the round function of the ith round applied to x.
+func (p *Permutation) round(mac hash.Hash, i int, x uint64) uint64 {
+	var b [17]byte
+	b[0] = byte(i)
+	binary.BigEndian.PutUint64(b[1:9], p.size)
+	binary.BigEndian.PutUint64(b[9:], x)
+	mac.Reset()
+	mac.Write(b[:])
+	return binary.BigEndian.Uint64(mac.Sum(nil))
+}
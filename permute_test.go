@@ -0,0 +1,83 @@
+package sequence
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPermutation(t *testing.T) {
+	tests := []*Ints{
+		New(WithFormat(NewBaseFmt(0, 9)), WithStart(4), WithEnd(4)),
+		New(WithFormat(NewBaseFmt(0, 6))),
+		New(WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9))),
+		New(
+			WithFormat(NewBaseFmt(0, 9), NewBaseFmt(1, 6), NewBaseFmt(0, 25)),
+			WithOrder(2, 0),
+			WithStart(1, 3, 4),
+			WithEnd(8, 3, 20),
+		),
+	}
+
+	for _, its := range tests {
+		p, err := NewPermutation(its, []byte("secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		size, _ := its.Len()
+		if p.Len() != size {
+			t.Fatalf("\nexpected %d\nreceived %d\n", size, p.Len())
+		}
+
+		seen := make(map[uint64]bool)
+		for n := uint64(0); n < p.Len(); n++ {
+			v, err := p.At(n)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			i, err := its.Index(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if seen[i] {
+				t.Fatalf("\n%v produced twice\n", v)
+			}
+
+			seen[i] = true
+			if m, err := p.Index(v); err != nil || m != n {
+				t.Fatalf("\nexpected %d\nreceived %d, %v\n", n, m, err)
+			}
+		}
+
+		if _, err := p.At(p.Len()); !errors.Is(err, ErrOutOfRange) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", ErrOutOfRange, err)
+		}
+	}
+
+	// Different keys give different orders.
+	its := tests[2]
+	p, _ := NewPermutation(its, []byte("secret"))
+	q, _ := NewPermutation(its, []byte("Secret"))
+	var same, ordered int
+	for n := uint64(0); n < p.Len(); n++ {
+		v, _ := p.At(n)
+		w, _ := q.At(n)
+		if equal(v, w) {
+			same++
+		}
+
+		if i, _ := its.Index(v); i == n {
+			ordered++
+		}
+	}
+
+	if 10 < same || 10 < ordered {
+		t.Fatalf("\nexpected few fixed points\nreceived %d shared and %d in order\n", same, ordered)
+	}
+
+	if _, err := NewPermutation(its, nil); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidKey, err)
+	}
+}
@@ -0,0 +1,221 @@
+package sequence
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// BoundsFunc returns the smallest and largest values a position may take
+// given a value of a sequence. It may depend only on the positions of the
+// value more significant than the position, or held at the start, and
+// must not modify the value. The bounds must lie within the base format
+// of the position and the minimum must not exceed the maximum.
+type BoundsFunc func(v []int) (min, max int)
+
+// WithBounds sets a function computing the bounds of a position from the
+// values of more significant positions, such as the number of days in a
+// month. The position must be in the order. Stepping by one value takes
+// time proportional to the number of positions, but indexing, measuring,
+// and moving by more than one value count the values below each position,
+// which takes time proportional to the number of combinations of the
+// positions more significant than the least significant one with bounds.
+// Adding or subtracting fields is not supported.
+func WithBounds(index int, fn BoundsFunc) Option {
+	return func(its *Ints) {
+		if its.bounds == nil {
+			its.bounds = make(map[int]BoundsFunc)
+		}
+
+		its.bounds[index] = fn
+	}
+}
+
+// errFieldDelta is returned when adding or subtracting a field to a
+// sequence with position bounds.
+var errFieldDelta = fmt.Errorf("%w: field deltas with position bounds", errors.ErrUnsupported)
+
+// initBounds validates the positions with bounds and precomputes the
+// number of values of the positions less significant than the least
+// significant position with bounds.
+func (its *Ints) initBounds() error {
+	its.lowBound = len(its.indQueue)
+	if its.bounds == nil {
+		return nil
+	}
+
+	sig := make([]int, its.dims)
+	for i := range sig {
+		sig[i] = -1
+	}
+
+	for qi, index := range its.indQueue {
+		sig[index] = qi
+	}
+
+	for index := range its.bounds {
+		if index < 0 || its.dims <= index || sig[index] < 0 {
+			return fmt.Errorf("%w: bounds of position %d not in order %v", ErrInvalidRange, index, []int(its.indQueue))
+		}
+
+		if sig[index] < its.lowBound {
+			its.lowBound = sig[index]
+		}
+	}
+
+	its.prods = make([]*big.Int, its.lowBound+1)
+	its.prods[0] = big.NewInt(1)
+	for qi := 0; qi < its.lowBound; qi++ {
+		bf := its.format[its.indQueue[qi]]
+		its.prods[qi+1] = new(big.Int).Mul(its.prods[qi], big.NewInt(int64(bf.max-bf.min+1)))
+	}
+
+	return nil
+}
+
+// limits returns the bounds of a position given a field.
+func (its *Ints) limits(index int, f field) (int, int) {
+	if fn, ok := its.bounds[index]; ok {
+		return fn([]int(f))
+	}
+
+	return its.format[index].min, its.format[index].max
+}
+
+// count returns the number of values the positions less significant than
+// the given index into the index queue may take, given the more
+// significant positions of a field. The less significant positions of the
+// field are used as scratch space and restored.
+func (its *Ints) count(qi int, f field) *big.Int {
+	if qi <= its.lowBound {
+		return new(big.Int).Set(its.prods[qi])
+	}
+
+	var (
+		index  = its.indQueue[qi-1]
+		lo, hi = its.limits(index, f)
+	)
+
+	if qi-1 <= its.lowBound {
+		n := big.NewInt(int64(hi - lo + 1))
+		return n.Mul(n, its.prods[qi-1])
+	}
+
+	var (
+		n     = new(big.Int)
+		saved = f[index]
+	)
+
+	for x := lo; x <= hi; x++ {
+		f[index] = x
+		n.Add(n, its.count(qi-1, f))
+	}
+
+	f[index] = saved
+	return n
+}
+
+// fill sets the positions less significant than the given index into the
+// index queue to their smallest values, if dir is positive, or largest
+// values, from the most significant down.
+func (its *Ints) fill(f field, qi, dir int) {
+	for i := qi - 1; 0 <= i; i-- {
+		index := its.indQueue[i]
+		lo, hi := its.limits(index, f)
+		if 0 < dir {
+			f[index] = lo
+		} else {
+			f[index] = hi
+		}
+	}
+}
+
+// rankBounded returns the rank of a field among all values of a sequence
+// with position bounds.
+func (its *Ints) rankBounded(f field) *big.Int {
+	var (
+		g = f.copy()
+		r = new(big.Int)
+		t = new(big.Int)
+	)
+
+	for qi := len(its.indQueue) - 1; 0 <= qi; qi-- {
+		index := its.indQueue[qi]
+		lo, _ := its.limits(index, g)
+		if qi <= its.lowBound {
+			r.Add(r, t.Mul(t.SetInt64(int64(f[index]-lo)), its.prods[qi]))
+			continue
+		}
+
+		for x := lo; x < f[index]; x++ {
+			g[index] = x
+			r.Add(r, its.count(qi, g))
+		}
+
+		g[index] = f[index]
+	}
+
+	return r
+}
+
+// unrankBounded returns the field at position n among all values of a
+// sequence with position bounds. It is the inverse of rankBounded.
+func (its *Ints) unrankBounded(n *big.Int) field {
+	var (
+		f    = field(its.start.copy())
+		q    = new(big.Int).Set(n)
+		m, c = new(big.Int), new(big.Int)
+	)
+
+	for qi := len(its.indQueue) - 1; 0 <= qi; qi-- {
+		index := its.indQueue[qi]
+		lo, hi := its.limits(index, f)
+		if qi <= its.lowBound {
+			q.DivMod(q, its.prods[qi], m)
+			f[index] = lo + int(q.Int64())
+			q.Set(m)
+			continue
+		}
+
+		for f[index] = lo; f[index] < hi; f[index]++ {
+			if c = its.count(qi, f); q.Cmp(c) < 0 {
+				break
+			}
+
+			q.Sub(q, c)
+		}
+	}
+
+	return f
+}
+
+// stepBounded moves a sequence with position bounds by n values. Moves
+// by one value are made position by position and larger moves on
+// ordinals.
+func (its *Ints) stepBounded(n *big.Int) error {
+	if n.CmpAbs(big.NewInt(1)) != 0 {
+		return its.step(n, nil)
+	}
+
+	dir := int(n.Int64())
+	return its.step(n, func(f field) int { return its.jumpBounded(f, 0, dir) })
+}
+
+// jumpBounded moves a field as jump does in a sequence with position
+// bounds. Moving past the last value of the format gives the first, and
+// moving before the first gives the last, with a carry of 1 or -1.
+func (its *Ints) jumpBounded(f field, pivot, dir int) int {
+	its.fill(f, pivot, dir)
+	for qi := pivot; qi < len(its.indQueue); qi++ {
+		index := its.indQueue[qi]
+		lo, hi := its.limits(index, f)
+		if 0 < dir && f[index] < hi || dir < 0 && lo < f[index] {
+			f[index] += dir
+			its.fill(f, qi, dir)
+			return 0
+		}
+	}
+
+	its.fill(f, len(its.indQueue), dir)
+	return dir
+}
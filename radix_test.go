@@ -0,0 +1,156 @@
+package sequence
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// newDates returns a sequence of dates YYMMDD from 2000 through 2099.
+func newDates(opts ...Option) *Ints {
+	days := func(v []int) (int, int) {
+		return 1, time.Date(2000+v[0], time.Month(v[1]+1), 0, 0, 0, 0, 0, time.UTC).Day()
+	}
+
+	opts = append([]Option{
+		WithFormat(NewBaseFmt(0, 99), NewBaseFmt(1, 12), NewBaseFmt(1, 31)),
+		WithBounds(2, days),
+	}, opts...)
+
+	return New(opts...)
+}
+
+func TestBounds(t *testing.T) {
+	its := newDates()
+	if n, err := its.Len(); err != nil || n != 36525 {
+		t.Fatalf("\nexpected 36525\nreceived %d, %v\n", n, err)
+	}
+
+	if !equal(its.End(), []int{99, 12, 31}) {
+		t.Fatalf("\nexpected [99 12 31]\nreceived %v\n", its.End())
+	}
+
+	day := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	for n := uint64(0); n < 36525; n++ {
+		exp := []int{day.Year() - 2000, int(day.Month()), day.Day()}
+		if v := its.Value(); !equal(v, exp) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp, v)
+		}
+
+		if n%97 == 0 {
+			if v, err := its.At(n); err != nil || !equal(v, exp) {
+				t.Fatalf("\nexpected %v\nreceived %v, %v\n", exp, v, err)
+			}
+
+			if i, err := its.Index(exp); err != nil || i != n {
+				t.Fatalf("\nexpected %d\nreceived %d, %v\n", n, i, err)
+			}
+		}
+
+		its.Next()
+		day = day.AddDate(0, 0, 1)
+	}
+
+	if !equal(its.Value(), []int{0, 1, 1}) || !its.Overflowed() {
+		t.Fatalf("\nexpected to wrap to [0 1 1]\nreceived %v\n", its.Value())
+	}
+
+	tests := []struct {
+		from []int
+		n    int
+		exp  []int
+	}{
+		{from: []int{0, 2, 28}, n: 1, exp: []int{0, 2, 29}},
+		{from: []int{1, 2, 28}, n: 1, exp: []int{1, 3, 1}},
+		{from: []int{4, 3, 1}, n: -1, exp: []int{4, 2, 29}},
+		{from: []int{23, 12, 31}, n: 60, exp: []int{24, 2, 29}},
+		{from: []int{24, 2, 29}, n: -366, exp: []int{23, 2, 28}},
+	}
+
+	for _, test := range tests {
+		its := newDates(WithCurrent(test.from...))
+		if err := its.AddN(test.n); err != nil || !equal(its.Value(), test.exp) {
+			t.Fatalf("\nexpected %v + %d = %v\nreceived %v, %v\n", test.from, test.n, test.exp, its.Value(), err)
+		}
+	}
+
+	if _, err := TryNew(
+		WithFormat(NewBaseFmt(0, 99), NewBaseFmt(1, 12), NewBaseFmt(1, 31)),
+		WithBounds(2, func(v []int) (int, int) { return 1, 28 }),
+		WithCurrent(1, 2, 30),
+	); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+
+	if _, err := TryNew(
+		WithFormat(NewBaseFmt(0, 99), NewBaseFmt(1, 12)),
+		WithOrder(1),
+		WithBounds(0, func(v []int) (int, int) { return 0, 9 }),
+	); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+
+	if err := its.Add([]int{0, 0, 1}); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", errors.ErrUnsupported, err)
+	}
+}
+
+func TestBoundsDependent(t *testing.T) {
+	// Each position is at most the one before it, so that the values are
+	// the non-increasing triples of digits, counted by brute force.
+	le := func(index int) BoundsFunc {
+		return func(v []int) (int, int) { return 0, v[index-1] }
+	}
+
+	its := New(
+		WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+		WithBounds(1, le(1)),
+		WithBounds(2, le(2)),
+		WithExcludedValues(1, 3),
+	)
+
+	var exp [][]int
+	for a := 0; a <= 9; a++ {
+		for b := 0; b <= a; b++ {
+			for c := 0; c <= b; c++ {
+				exp = append(exp, []int{a, b, c})
+			}
+		}
+	}
+
+	if n, _ := its.Len(); n != uint64(len(exp)) {
+		t.Fatalf("\nexpected %d\nreceived %d\n", len(exp), n)
+	}
+
+	var i int
+	for n, v := range its.All() {
+		for exp[i][1] == 3 {
+			i++
+		}
+
+		if !equal(v, exp[i]) || n != uint64(i) {
+			t.Fatalf("\nexpected %d: %v\nreceived %d: %v\n", i, exp[i], n, v)
+		}
+
+		i++
+	}
+
+	its.Reset()
+	its.Prev()
+	for j := len(exp) - 1; 0 <= j; j-- {
+		if exp[j][1] == 3 {
+			continue
+		}
+
+		if v := its.Value(); !equal(v, exp[j]) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp[j], v)
+		}
+
+		its.Prev()
+	}
+
+	if s := fmt.Sprint(its.Value()); s != "[9 9 9]" {
+		t.Fatalf("\nexpected [9 9 9]\nreceived %s\n", s)
+	}
+}
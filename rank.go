@@ -0,0 +1,161 @@
+package sequence
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// At returns the nth value of the sequence, counted from its start.
+// Values excluded from iteration are counted and may be returned.
+func (its *Ints) At(n uint64) ([]int, error) {
+	return its.BigAt(new(big.Int).SetUint64(n))
+}
+
+// BigAt returns the nth value of the sequence, counted from its start.
+func (its *Ints) BigAt(n *big.Int) ([]int, error) {
+	if n.Sign() < 0 || its.size().Cmp(n) <= 0 {
+		return nil, fmt.Errorf("%w: index %v", ErrOutOfRange, n)
+	}
+
+	return []int(its.unrank(new(big.Int).Add(its.rank(field(its.start)), n))), nil
+}
+
+// BigIndex returns the position of a value in the sequence, counted from
+// its start.
+func (its *Ints) BigIndex(v []int) (*big.Int, error) {
+	if err := its.validate(v); err != nil {
+		return nil, err
+	}
+
+	if !its.contains(v) {
+		return nil, fmt.Errorf("%w: %v", ErrOutOfRange, v)
+	}
+
+	return new(big.Int).Sub(its.rank(v), its.rank(field(its.start))), nil
+}
+
+// Index returns the position of a value in the sequence, counted from
+// its start. ErrOverflow is returned if the position does not fit in 64
+// bits, in which case BigIndex should be used.
+func (its *Ints) Index(v []int) (uint64, error) {
+	n, err := its.BigIndex(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if !n.IsUint64() {
+		return 0, fmt.Errorf("%w: index %v", ErrOverflow, n)
+	}
+
+	return n.Uint64(), nil
+}
+
+// contains reports whether a valid field lies between the start and end
+// of the sequence. Positions absent from the index queue must match the
+// start.
+func (its *Ints) contains(f field) bool {
+	if !its.isHeld(f) {
+		return false
+	}
+
+	if c, _ := field(its.start).compare(f, its.indQueue); 0 < c {
+		return false
+	}
+
+	c, _ := f.compare(field(its.end), its.indQueue)
+	return c <= 0
+}
+
+// isHeld reports whether each position absent from the index queue
+// matches the start.
+func (its *Ints) isHeld(f field) bool {
+	queued := make([]bool, its.dims)
+	for _, index := range its.indQueue {
+		queued[index] = true
+	}
+
+	for i, q := range queued {
+		if !q && f[i] != its.start[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rank returns the position of a field among all values of the format,
+// ignoring the start and end. It is the mixed-radix number whose digits
+// are the positions of the field offset by their minimums, in the order
+// of the index queue.
+func (its *Ints) rank(f field) *big.Int {
+	if its.bounds != nil {
+		return its.rankBounded(f)
+	}
+
+	var (
+		r = new(big.Int)
+		t = new(big.Int)
+	)
+
+	for i := len(its.indQueue) - 1; 0 <= i; i-- {
+		bf := its.format[its.indQueue[i]]
+		r.Mul(r, t.SetInt64(int64(bf.max-bf.min+1)))
+		r.Add(r, t.SetInt64(int64(f[its.indQueue[i]]-bf.min)))
+	}
+
+	return r
+}
+
+// size returns the number of values from the start through the end.
+func (its *Ints) size() *big.Int {
+	n := new(big.Int).Sub(its.rank(field(its.end)), its.rank(field(its.start)))
+	if n.Sign() < 0 {
+		return n.SetInt64(0)
+	}
+
+	return n.Add(n, big.NewInt(1))
+}
+
+// unrank64 returns the field at position n among all values of the
+// format, as unrank does, for positions that fit in 64 bits.
+func (its *Ints) unrank64(n uint64) field {
+	if its.bounds != nil {
+		return its.unrankBounded(new(big.Int).SetUint64(n))
+	}
+
+	f := field(its.start.copy())
+	for _, index := range its.indQueue {
+		var (
+			bf    = its.format[index]
+			radix = uint64(bf.max - bf.min + 1)
+		)
+
+		f[index] = bf.min + int(n%radix)
+		n /= radix
+	}
+
+	return f
+}
+
+// unrank returns the field at position n among all values of the format.
+// It is the inverse of rank. Positions absent from the index queue are
+// taken from the start.
+func (its *Ints) unrank(n *big.Int) field {
+	if its.bounds != nil {
+		return its.unrankBounded(n)
+	}
+
+	var (
+		f    = field(its.start.copy())
+		q    = new(big.Int).Set(n)
+		m, t = new(big.Int), new(big.Int)
+	)
+
+	for _, index := range its.indQueue {
+		bf := its.format[index]
+		q.DivMod(q, t.SetInt64(int64(bf.max-bf.min+1)), m)
+		f[index] = bf.min + int(m.Int64())
+	}
+
+	return f
+}
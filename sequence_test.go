@@ -1,45 +1,594 @@
 package sequence
 
 import (
-	"fmt"
+	"errors"
+	"math"
+	"math/big"
 	"testing"
 )
 
 func TestInts(t *testing.T) {
-	its := newInts(
-		format{
-			newBaseFmt(0, 3),
-			newBaseFmt(0, 3),
-		},
+	its := New(
+		WithFormat(
+			NewBaseFmt(0, 3),
+			NewBaseFmt(0, 3),
+		),
 	)
 
-	for i := 0; i < 4; i++ {
-		its.increment()
-		fmt.Println(its.current)
+	exp := [][]int{{0, 1}, {0, 2}, {0, 3}, {1, 0}, {1, 1}}
+	for _, e := range exp {
+		its.Next()
+		if rec := its.Value(); !equal(e, rec) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", e, rec)
+		}
+	}
+
+	exp = [][]int{{1, 0}, {0, 3}, {0, 2}, {0, 1}, {0, 0}}
+	for _, e := range exp {
+		its.Prev()
+		if rec := its.Value(); !equal(e, rec) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", e, rec)
+		}
+	}
+}
+
+func TestOptions(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(1, 2), NewBaseFmt(0, 9), NewBaseFmt(5, 6)),
+		WithOrder(0, 1, 2),
+		WithCurrent(2, 9, 5),
+	)
+
+	if exp, rec := []int{1, 0, 5}, its.Start(); !equal(exp, rec) {
+		t.Fatalf("\nexpected start %v\nreceived %v\n", exp, rec)
+	}
+
+	if exp, rec := []int{2, 9, 6}, its.End(); !equal(exp, rec) {
+		t.Fatalf("\nexpected end %v\nreceived %v\n", exp, rec)
+	}
+
+	its.Next()
+	if exp, rec := []int{1, 0, 6}, its.Value(); !equal(exp, rec) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+	}
+
+	v := its.Value()
+	v[0] = 2
+	if its.Value()[0] != 1 {
+		t.Fatalf("\nvalue shares memory with the sequence\n")
+	}
+}
+
+func TestTryNew(t *testing.T) {
+	f := WithFormat(NewBaseFmt(0, 3), NewBaseFmt(0, 3))
+	tests := []struct {
+		opts []Option
+		err  error
+	}{
+		{opts: []Option{f}},
+		{opts: nil, err: ErrFormatRequired},
+		{opts: []Option{f, WithOrder(0, 2)}, err: ErrInvalidOrder},
+		{opts: []Option{f, WithOrder(1, 1)}, err: ErrInvalidOrder},
+		{opts: []Option{f, WithStart(0)}, err: ErrDimensionMismatch},
+		{opts: []Option{f, WithEnd(0, 4)}, err: ErrInvalidRange},
+	}
+
+	for i, test := range tests {
+		if _, err := TryNew(test.opts...); !errors.Is(err, test.err) {
+			t.Fatalf("\ntest %d\nexpected %v\nreceived %v\n", i, test.err, err)
+		}
+	}
+
+	if _, err := TryNewBaseFmt(3, 2); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
 	}
 
-	for i := 0; i < 4; i++ {
-		its.decrement()
-		fmt.Println(its.current)
+	its := New(f)
+	_, err := its.Compare([]int{0}, []int{0, 0})
+	var de *DimensionError
+	if !errors.As(err, &de) || de.Expected != 2 || de.Received != 1 {
+		t.Fatalf("\nexpected dimension error\nreceived %v\n", err)
 	}
 
-	t.Fatal()
+	if c, err := its.Compare([]int{1, 0}, []int{0, 3}); err != nil || c != 1 {
+		t.Fatalf("\nexpected 1\nreceived %d, %v\n", c, err)
+	}
 }
 
 func TestAddSubtract(t *testing.T) {
-	its := newInts(newFormat(newBaseFmt(0, 3), newBaseFmt(0, 3)))
-	fmt.Printf("%v\n", its.current)
+	its := New(WithFormat(NewBaseFmt(0, 3), NewBaseFmt(0, 3)))
+	exp := [][]int{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 0}, {1, 1}, {1, 2}}
+	for i := 1; i < len(exp); i++ {
+		its.Next()
+		if rec := its.Value(); !equal(exp[i], rec) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp[i], rec)
+		}
+	}
+
+	for i := len(exp) - 2; 0 <= i; i-- {
+		its.Prev()
+		if rec := its.Value(); !equal(exp[i], rec) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", exp[i], rec)
+		}
+	}
+
+	if its.Overflowed() || its.Underflowed() {
+		t.Fatalf("\nexpected no overflow or underflow\n")
+	}
+
+	its.Prev()
+	if exp, rec := []int{3, 3}, its.Value(); !equal(exp, rec) || !its.Underflowed() {
+		t.Fatalf("\nexpected %v with underflow\nreceived %v\n", exp, rec)
+	}
+}
+
+func TestAddField(t *testing.T) {
+	tests := []struct {
+		current, delta, exp []int
+		subtract            bool
+		overflowed          bool
+		underflowed         bool
+	}{
+		{current: []int{1, 2, 3}, delta: []int{0, 1, 1}, exp: []int{1, 3, 4}},
+		{current: []int{1, 2, 3}, delta: []int{0, 0, 2}, exp: []int{1, 3, 0}},
+		{current: []int{1, 2, 3}, delta: []int{0, 0, 7}, exp: []int{2, 0, 0}},
+		{current: []int{2, 3, 4}, delta: []int{0, 0, 1}, exp: []int{1, 0, 0}, overflowed: true},
+		{current: []int{1, 2, 3}, delta: []int{2, 0, 0}, exp: []int{1, 2, 3}, overflowed: true},
+		{current: []int{1, 2, 3}, delta: []int{0, 0, -4}, exp: []int{1, 1, 4}},
+		{current: []int{1, 2, 3}, delta: []int{0, 1, 4}, exp: []int{1, 0, 4}, subtract: true},
+		{current: []int{1, 0, 0}, delta: []int{0, 0, 1}, exp: []int{2, 3, 4}, subtract: true, underflowed: true},
+		{current: []int{1, 2, 3}, delta: []int{0, 0, -2}, exp: []int{1, 3, 0}, subtract: true},
+	}
+
+	for i, test := range tests {
+		its := New(
+			WithFormat(NewBaseFmt(1, 2), NewBaseFmt(0, 3), NewBaseFmt(0, 4)),
+			WithCurrent(test.current...),
+		)
+
+		var err error
+		if test.subtract {
+			err = its.Subtract(test.delta)
+		} else {
+			err = its.Add(test.delta)
+		}
+
+		if rec := its.Value(); err != nil || !equal(test.exp, rec) || test.overflowed != its.Overflowed() || test.underflowed != its.Underflowed() {
+			t.Fatalf("\ntest %d\nexpected %v (%t,%t)\nreceived %v (%t,%t), %v\n", i, test.exp, test.overflowed, test.underflowed, rec, its.Overflowed(), its.Underflowed(), err)
+		}
+	}
+}
+
+func TestAddN(t *testing.T) {
+	// A 3-digit decimal counter.
+	f := WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9), NewBaseFmt(0, 9))
+	tests := []struct {
+		current    []int
+		n          int
+		exp        []int
+		overflowed bool
+	}{
+		{current: []int{0, 0, 0}, n: 123, exp: []int{1, 2, 3}},
+		{current: []int{1, 2, 3}, n: 877, exp: []int{0, 0, 0}, overflowed: true},
+		{current: []int{1, 2, 3}, n: 2000, exp: []int{1, 2, 3}, overflowed: true},
+		{current: []int{1, 2, 3}, n: -24, exp: []int{0, 9, 9}},
+	}
+
+	for _, test := range tests {
+		its := New(f, WithCurrent(test.current...))
+		its.AddN(test.n)
+		if rec := its.Value(); !equal(test.exp, rec) || test.overflowed != its.Overflowed() {
+			t.Fatalf("\nexpected %v+%d = %v\nreceived %v\n", test.current, test.n, test.exp, rec)
+		}
+
+		its.SubtractN(test.n)
+		if rec := its.Value(); !equal(test.current, rec) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", test.current, rec)
+		}
+	}
+
+	// 2^63 is 808 modulo 1000.
+	its := New(f, WithCurrent(1, 2, 3))
+	if its.SubtractN(math.MinInt); !equal([]int{9, 3, 1}, its.Value()) || !its.Overflowed() {
+		t.Fatalf("\nexpected [9 3 1]\nreceived %v\n", its.Value())
+	}
+
+	if its.AddN(math.MinInt); !equal([]int{1, 2, 3}, its.Value()) || !its.Underflowed() {
+		t.Fatalf("\nexpected [1 2 3]\nreceived %v\n", its.Value())
+	}
+
+	if err := New(f).Add([]int{1}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrDimensionMismatch, err)
+	}
+}
+
+func TestIndexAt(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(1, 2), NewBaseFmt(0, 3), NewBaseFmt(0, 4)),
+		WithOrder(0, 2, 1),
+		WithStart(2, 0, 1),
+		WithEnd(1, 3, 2),
+	)
+
+	var n uint64
+	for v := its.Value(); ; n++ {
+		i, err := its.Index(v)
+		if err != nil || i != n {
+			t.Fatalf("\nexpected index of %v to be %d\nreceived %d, %v\n", v, n, i, err)
+		}
+
+		w, err := its.At(n)
+		if err != nil || !equal(v, w) {
+			t.Fatalf("\nexpected value at %d to be %v\nreceived %v, %v\n", n, v, w, err)
+		}
+
+		if equal(v, its.End()) {
+			break
+		}
+
+		its.Next()
+		v = its.Value()
+	}
+
+	if _, err := its.At(n + 1); !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrOutOfRange, err)
+	}
+
+	if _, err := its.Index([]int{1, 0, 1}); !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrOutOfRange, err)
+	}
+
+	if _, err := its.Index([]int{1, 0, 5}); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+}
+
+func TestBigIndex(t *testing.T) {
+	bfs := make([]BaseFmt, 30)
+	for i := range bfs {
+		bfs[i] = NewBaseFmt(0, 9)
+	}
+
+	its := New(WithFormat(bfs...))
+	v := its.End()
+	if _, err := its.Index(v); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrOverflow, err)
+	}
+
+	n, err := its.BigIndex(v)
+	exp, _ := new(big.Int).SetString("999999999999999999999999999999", 10)
+	if err != nil || n.Cmp(exp) != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v, %v\n", exp, n, err)
+	}
+
+	w, err := its.BigAt(n)
+	if err != nil || !equal(v, w) {
+		t.Fatalf("\nexpected %v\nreceived %v, %v\n", v, w, err)
+	}
+}
+
+func TestLenDistanceContains(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+		WithStart(1, 2, 0),
+		WithEnd(3, 4, 5),
+	)
+
+	if n, err := its.Len(); err != nil || n != 226 {
+		t.Fatalf("\nexpected length 226\nreceived %d, %v\n", n, err)
+	}
+
+	tests := []struct {
+		a, b []int
+		exp  int64
+	}{
+		{a: []int{1, 2, 0}, b: []int{3, 4, 5}, exp: 225},
+		{a: []int{3, 4, 5}, b: []int{1, 2, 0}, exp: -225},
+		{a: []int{0, 0, 0}, b: []int{9, 9, 9}, exp: 999},
+		{a: []int{2, 0, 0}, b: []int{2, 0, 0}, exp: 0},
+	}
+
+	for _, test := range tests {
+		if d, err := its.Distance(test.a, test.b); err != nil || d != test.exp {
+			t.Fatalf("\nexpected distance from %v to %v to be %d\nreceived %d, %v\n", test.a, test.b, test.exp, d, err)
+		}
+	}
+
+	for _, v := range [][]int{{1, 2, 0}, {2, 9, 9}, {3, 4, 5}} {
+		if !its.Contains(v) {
+			t.Fatalf("\nexpected %v to be contained\n", v)
+		}
+	}
+
+	for _, v := range [][]int{{1, 1, 9}, {3, 4, 6}, {3, 4}, {3, 4, 10}} {
+		if its.Contains(v) {
+			t.Fatalf("\nexpected %v not to be contained\n", v)
+		}
+	}
+
+	// Positions held out of the order must match the start.
+	held := New(WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithOrder(1), WithStart(4, 0))
+	if n, err := held.Len(); err != nil || n != 10 {
+		t.Fatalf("\nexpected length 10\nreceived %d, %v\n", n, err)
+	}
+
+	if held.Contains([]int{5, 0}) {
+		t.Fatalf("\nexpected %v not to be contained\n", []int{5, 0})
+	}
+
+	bfs := make([]BaseFmt, 20)
+	for i := range bfs {
+		bfs[i] = NewBaseFmt(0, 9)
+	}
+
+	large := New(WithFormat(bfs...))
+	if _, err := large.Len(); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrOverflow, err)
+	}
+
+	if exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil); large.BigLen().Cmp(exp) != 0 {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, large.BigLen())
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	// Values 12, 13, ..., 21 of a 2-digit decimal counter.
+	opts := []Option{
+		WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)),
+		WithStart(1, 2),
+		WithEnd(2, 1),
+	}
+
+	tests := []struct {
+		policy      Policy
+		current     []int
+		n           int
+		exp         []int
+		err         error
+		overflowed  bool
+		underflowed bool
+	}{
+		{policy: Wrap, current: []int{2, 0}, n: 1, exp: []int{2, 1}},
+		{policy: Wrap, current: []int{2, 1}, n: 1, exp: []int{1, 2}, overflowed: true},
+		{policy: Wrap, current: []int{2, 0}, n: 13, exp: []int{1, 3}, overflowed: true},
+		{policy: Wrap, current: []int{1, 3}, n: -2, exp: []int{2, 1}, underflowed: true},
+		{policy: Saturate, current: []int{2, 0}, n: 5, exp: []int{2, 1}, overflowed: true},
+		{policy: Saturate, current: []int{1, 3}, n: -5, exp: []int{1, 2}, underflowed: true},
+		{policy: Exhaust, current: []int{2, 0}, n: 1, exp: []int{2, 1}},
+		{policy: Exhaust, current: []int{2, 0}, n: 2, exp: []int{2, 0}, err: ErrExhausted, overflowed: true},
+		{policy: Exhaust, current: []int{1, 2}, n: -1, exp: []int{1, 2}, err: ErrExhausted, underflowed: true},
+	}
+
+	for i, test := range tests {
+		its := New(append(opts, WithPolicy(test.policy), WithCurrent(test.current...))...)
+		err := its.AddN(test.n)
+		if rec := its.Value(); !errors.Is(err, test.err) || !equal(test.exp, rec) || test.overflowed != its.Overflowed() || test.underflowed != its.Underflowed() {
+			t.Fatalf("\ntest %d\nexpected %v (%t,%t), %v\nreceived %v (%t,%t), %v\n", i, test.exp, test.overflowed, test.underflowed, test.err, rec, its.Overflowed(), its.Underflowed(), err)
+		}
+	}
+
+	// Field deltas and single steps are bounded in the same way.
+	its := New(append(opts, WithPolicy(Exhaust), WithCurrent(2, 0))...)
+	if err := its.Add([]int{0, 2}); !errors.Is(err, ErrExhausted) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrExhausted, err)
+	}
+
+	if err := its.Subtract([]int{0, 8}); err != nil || !equal([]int{1, 2}, its.Value()) {
+		t.Fatalf("\nexpected %v\nreceived %v, %v\n", []int{1, 2}, its.Value(), err)
+	}
+
+	if err := its.Prev(); !errors.Is(err, ErrExhausted) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrExhausted, err)
+	}
+
+	its.Reset()
+	if its.Underflowed() || !equal(its.Start(), its.Value()) {
+		t.Fatalf("\nexpected reset to %v\nreceived %v\n", its.Start(), its.Value())
+	}
+
+	if _, err := TryNew(append(opts, WithCurrent(2, 2))...); !errors.Is(err, ErrOutOfRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrOutOfRange, err)
+	}
+
+	if _, err := TryNew(opts[0], WithStart(2, 1), WithEnd(1, 2)); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+	}
+}
+
+func TestOverflowFunc(t *testing.T) {
+	var (
+		calls   []bool
+		errStop = errors.New("stop")
+		its     = New(
+			WithFormat(NewBaseFmt(0, 2)),
+			WithOverflowFunc(func(its *Ints, overflowed bool) error {
+				calls = append(calls, overflowed)
+				if len(calls) == 3 {
+					return errStop
+				}
+
+				return nil
+			}),
+		)
+	)
+
+	for i := 0; i < 3; i++ {
+		if err := its.Next(); err != nil {
+			t.Fatalf("\nexpected no error\nreceived %v\n", err)
+		}
+	}
+
+	if err := its.Prev(); err != nil || !equal([]int{2}, its.Value()) {
+		t.Fatalf("\nexpected %v\nreceived %v, %v\n", []int{2}, its.Value(), err)
+	}
+
+	if err := its.Next(); err != errStop || !equal([]int{2}, its.Value()) {
+		t.Fatalf("\nexpected %v with %v\nreceived %v, %v\n", []int{2}, errStop, its.Value(), err)
+	}
+
+	if exp := []bool{true, false, true}; len(calls) != len(exp) || calls[0] != exp[0] || calls[1] != exp[1] || calls[2] != exp[2] {
+		t.Fatalf("\nexpected %v\nreceived %v\n", exp, calls)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	its := New(
+		WithFormat(NewBaseFmt(0, 9), NewBaseFmt(1, 6), NewBaseFmt(0, 9)),
+		WithOrder(2, 0),
+		WithStart(1, 3, 4),
+		WithEnd(3, 3, 2),
+		WithCurrent(2, 3, 0),
+	)
+
+	parts, err := its.Split(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1_4 through 3_2 is 19 values.
+	exp := [][2][]int{
+		{{1, 3, 4}, {2, 3, 0}},
+		{{2, 3, 1}, {2, 3, 6}},
+		{{2, 3, 7}, {3, 3, 2}},
+	}
+
+	for i, part := range parts {
+		if !equal(part.Start(), exp[i][0]) || !equal(part.End(), exp[i][1]) || !equal(part.Value(), exp[i][0]) {
+			t.Fatalf("\nexpected %v\nreceived %v %v %v\n", exp[i], part.Start(), part.Value(), part.End())
+		}
+	}
+
+	// The parts iterate independently and cover the sequence in order.
+	var vs [][]int
+	for _, part := range parts {
+		for {
+			vs = append(vs, part.Value())
+			if c, _ := part.Compare(part.Value(), part.End()); c == 0 {
+				break
+			}
+
+			if err := part.Next(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if len(vs) != 19 {
+		t.Fatalf("\nexpected 19 values\nreceived %d\n", len(vs))
+	}
+
+	for i, v := range vs {
+		if w, _ := its.At(uint64(i)); !equal(v, w) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", w, v)
+		}
+	}
+
+	if !equal(its.Value(), []int{2, 3, 0}) {
+		t.Fatalf("\nexpected [2 3 0]\nreceived %v\n", its.Value())
+	}
+
+	for _, k := range []int{0, 20} {
+		if _, err := its.Split(k); !errors.Is(err, ErrInvalidRange) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidRange, err)
+		}
+	}
+
+	// Parts begin at their first value not excluded.
+	its = New(WithFormat(NewBaseFmt(0, 9)), WithExcludedValues(0, 5))
+	if parts, err = its.Split(2); err != nil || !equal(parts[1].Value(), []int{6}) {
+		t.Fatalf("\nexpected [6]\nreceived %v, %v\n", parts[1].Value(), err)
+	}
+
+	// The second part, 5 through 9, is entirely excluded.
+	for _, policy := range []Policy{Wrap, Saturate, Exhaust} {
+		its = New(WithFormat(NewBaseFmt(0, 9)), WithExcludedValues(0, 5, 6, 7, 8, 9), WithPolicy(policy))
+		if _, err := its.Split(2); !errors.Is(err, ErrExhausted) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", ErrExhausted, err)
+		}
+	}
+}
+
+func TestStride(t *testing.T) {
+	tests := []struct {
+		opts []Option
+		exp  [][]int
+	}{
+		{
+			// Every 7th value, wrapping past the end.
+			opts: []Option{WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithEnd(1, 9), WithStride(7)},
+			exp:  [][]int{{0, 0}, {0, 7}, {1, 4}, {0, 1}, {0, 8}, {1, 5}},
+		},
+		{
+			opts: []Option{WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithCurrent(0, 3), WithStride(-2)},
+			exp:  [][]int{{0, 3}, {0, 1}, {9, 9}, {9, 7}},
+		},
+		{
+			// Advance the most significant position by two, carrying into
+			// nothing and wrapping.
+			opts: []Option{WithFormat(NewBaseFmt(1, 5), NewBaseFmt(0, 9)), WithFieldStride(2, 0)},
+			exp:  [][]int{{1, 0}, {3, 0}, {5, 0}, {2, 0}, {4, 0}},
+		},
+		{
+			opts: []Option{WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithFieldStride(1, 5)},
+			exp:  [][]int{{0, 0}, {1, 5}, {3, 0}, {4, 5}},
+		},
+	}
+
+	for _, test := range tests {
+		its := New(test.opts...)
+		for i, exp := range test.exp {
+			if i != 0 {
+				if err := its.Next(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if v := its.Value(); !equal(exp, v) {
+				t.Fatalf("\nexpected %v\nreceived %v\n", exp, v)
+			}
+		}
+
+		for i := len(test.exp) - 2; 0 <= i; i-- {
+			if err := its.Prev(); err != nil {
+				t.Fatal(err)
+			}
+
+			if v := its.Value(); !equal(test.exp[i], v) {
+				t.Fatalf("\nexpected %v\nreceived %v\n", test.exp[i], v)
+			}
+		}
+	}
+
+	its := New(WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithStride(7), WithPolicy(Exhaust))
+	its.AddN(98)
+	if err := its.Next(); !errors.Is(err, ErrExhausted) || !equal(its.Value(), []int{9, 8}) {
+		t.Fatalf("\nexpected %v at [9 8]\nreceived %v at %v\n", ErrExhausted, err, its.Value())
+	}
+
+	errTests := [][]Option{
+		{WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithFieldStride(1)},
+		{WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithFieldStride(0, 0)},
+		{WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithFieldStride(1, -10)},
+		{WithFormat(NewBaseFmt(0, 9), NewBaseFmt(0, 9)), WithOrder(1), WithFieldStride(1, 1)},
+	}
+
+	for _, opts := range errTests {
+		if _, err := TryNew(opts...); err == nil {
+			t.Fatalf("\nexpected error\nreceived %v\n", err)
+		}
+	}
+}
 
-	for i := 0; i < 6; i++ {
-		its.increment()
-		fmt.Printf("%v\n", its.current)
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
 	}
 
-	fmt.Println()
-	for i := 0; i < 6; i++ {
-		its.decrement()
-		fmt.Printf("%v\n", its.current)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
 	}
 
-	t.Fatal()
+	return true
 }
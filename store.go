@@ -0,0 +1,156 @@
+package sequence
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// State is the persisted state of a sequence.
+type State struct {
+	// Format is the minimum and maximum of each position.
+	Format [][2]int `json:"format"`
+
+	// Order is the index queue, from the least significant position to
+	// the most significant.
+	Order []int `json:"order"`
+
+	// Start and End bound the sequence.
+	Start []int `json:"start"`
+	End   []int `json:"end"`
+
+	// Current is the first value that has not been handed out. It is
+	// meaningless if Exhausted is true.
+	Current []int `json:"current"`
+
+	// Exhausted is true if every value has been handed out.
+	Exhausted bool `json:"exhausted"`
+
+	// ExcludedValues, ExcludedChars, and ExcludedWords are the values,
+	// characters, and words excluded from iteration, sorted.
+	ExcludedValues map[int][]int  `json:"excludedValues,omitempty"`
+	ExcludedChars  map[int]string `json:"excludedChars,omitempty"`
+	ExcludedWords  []string       `json:"excludedWords,omitempty"`
+}
+
+// Store persists the state of a sequence.
+type Store interface {
+	// Load returns the most recently saved state, or ErrNoState if no
+	// state has been saved.
+	Load() (*State, error)
+
+	// Save persists a state. Once it returns without error, the state
+	// must survive a crash.
+	Save(s *State) error
+}
+
+// MemStore is a store held in memory. It is safe for concurrent use.
+type MemStore struct {
+	mu    sync.Mutex
+	state []byte
+}
+
+// Load returns the most recently saved state.
+func (ms *MemStore) Load() (*State, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if ms.state == nil {
+		return nil, ErrNoState
+	}
+
+	var s State
+	if err := json.Unmarshal(ms.state, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Save stores a copy of a state.
+func (ms *MemStore) Save(s *State) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.state = b
+	return nil
+}
+
+// FileStore is a store backed by a JSON file. States are saved by
+// writing a temporary file, syncing it, and renaming it over the file, so
+// the file always holds a complete state.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore returns a store backed by the file at the given path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load reads the state from the file.
+func (fs *FileStore) Load() (*State, error) {
+	b, err := os.ReadFile(fs.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNoState
+		}
+
+		return nil, err
+	}
+
+	var s State
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", fs.path, err)
+	}
+
+	return &s, nil
+}
+
+// Save atomically replaces the file with a state.
+func (fs *FileStore) Save(s *State) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(fs.path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(fs.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), fs.path); err != nil {
+		return err
+	}
+
+	// Sync the directory so the rename itself survives a crash.
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	defer d.Close()
+	return d.Sync()
+}
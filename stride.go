@@ -0,0 +1,82 @@
+package sequence
+
+import "fmt"
+
+// WithStride sets the number of values Next advances and Prev moves back
+// the sequence by. It defaults to one and may be negative, which reverses
+// the direction of iteration.
+func WithStride(n int) Option {
+	return func(its *Ints) {
+		its.stride = n
+		its.fieldStride = nil
+	}
+}
+
+// WithFieldStride sets the delta Next adds to and Prev subtracts from the
+// sequence, position by position with carrying, as by Add and Subtract.
+// It has a position for each base format and must not be zero at every
+// position. Positions absent from the order must be zero.
+func WithFieldStride(delta ...int) Option {
+	return func(its *Ints) {
+		its.stride = 0
+		its.fieldStride = make(field, len(delta))
+		copy(its.fieldStride, delta)
+	}
+}
+
+// Stride returns the number of values Next advances the sequence by, or
+// zero if the sequence has a field stride.
+func (its *Ints) Stride() int {
+	if its.fieldStride != nil {
+		return 0
+	}
+
+	return its.stride
+}
+
+// FieldStride returns the delta Next adds to the sequence, or nil if the
+// sequence has a scalar stride.
+func (its *Ints) FieldStride() []int {
+	if its.fieldStride == nil {
+		return nil
+	}
+
+	return []int(its.fieldStride.copy())
+}
+
+// initStride defaults the stride of the sequence to one and validates its
+// field stride, if any.
+func (its *Ints) initStride() error {
+	if its.fieldStride == nil {
+		if its.stride == 0 {
+			its.stride = 1
+		}
+
+		return nil
+	}
+
+	if its.bounds != nil {
+		return errFieldDelta
+	}
+
+	if len(its.fieldStride) != its.dims {
+		return &DimensionError{Expected: its.dims, Received: len(its.fieldStride)}
+	}
+
+	queued := make([]bool, its.dims)
+	for _, index := range its.indQueue {
+		queued[index] = true
+	}
+
+	for i, d := range its.fieldStride {
+		if !queued[i] && d != 0 {
+			return fmt.Errorf("%w: stride %v moves held position %d", ErrInvalidRange, []int(its.fieldStride), i)
+		}
+	}
+
+	if its.weigh(its.fieldStride).Sign() == 0 {
+		return fmt.Errorf("%w: stride %v does not move the sequence", ErrInvalidRange, []int(its.fieldStride))
+	}
+
+	return nil
+}
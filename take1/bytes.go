@@ -1,5 +1,12 @@
 package sequence
 
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
 // CharacterType ...
 type CharacterType byte
 
@@ -12,9 +19,27 @@ const (
 	ASCIINumeric = CharacterType('#')
 	// ASCIIAlphanumeric ...
 	ASCIIAlphanumeric = CharacterType('!')
+	// Custom is any ordered alphabet of distinct bytes.
+	Custom = CharacterType('*')
 )
 
-// Bytes implements the iterator interface.
+const (
+	// Crockford32 is Crockford's base 32 alphabet, which omits I, L, O,
+	// and U.
+	Crockford32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// Base58 is the base 58 alphabet, which omits 0, I, O, and l.
+	Base58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// HexLower is the base 16 alphabet in lower case.
+	HexLower = "0123456789abcdef"
+
+	// HexUpper is the base 16 alphabet in upper case.
+	HexUpper = "0123456789ABCDEF"
+)
+
+// Bytes is a sequence of byte fields. It implements the Iterator
+// interface.
 type Bytes struct {
 	overflowed bool
 	dims       int
@@ -24,8 +49,11 @@ type Bytes struct {
 	format     Format
 	incOrder   IncrementOrder
 	skip       SkipFnc
+	exceptions Exceptions
 }
 
+var _ Iterator = (*Bytes)(nil)
+
 // Start ...
 type Start Field
 
@@ -35,7 +63,7 @@ type Current Field
 // End ...
 type End Field
 
-// Exceptions ...
+// Exceptions are characters that no value of a sequence may contain.
 type Exceptions map[byte]struct{}
 
 // Format ...
@@ -51,16 +79,29 @@ func NewFormat(cfs ...CharFmt) Format {
 	return f
 }
 
-// CharFmt ...
+// CharFmt is the ordered alphabet of characters a position may take.
+// The first and last characters of the alphabet are its min and max.
 type CharFmt struct {
 	charType CharacterType
 	min, max byte
+	alphabet string
 }
 
 // NewCharFmt ...
 func NewCharFmt(min, max byte, incOrder int) CharFmt {
+	cf, err := TryNewCharFmt(min, max, incOrder)
+	if err != nil {
+		panic(err)
+	}
+
+	return cf
+}
+
+// TryNewCharFmt returns a character format over the range [min,max]. The
+// range must lie within a single character type.
+func TryNewCharFmt(min, max byte, incOrder int) (CharFmt, error) {
 	if max < min {
-		panic("invalid range")
+		return CharFmt{}, fmt.Errorf("%w: [%d,%d]", ErrInvalidRange, min, max)
 	}
 
 	cf := CharFmt{
@@ -78,26 +119,109 @@ func NewCharFmt(min, max byte, incOrder int) CharFmt {
 	case '0' <= min && min <= '9' && 'A' <= max && max <= 'Z':
 		cf.charType = ASCIIAlphanumeric
 	default:
-		panic("invalid character type detected")
+		return CharFmt{}, fmt.Errorf("%w: [%d,%d]", ErrInvalidCharType, min, max)
 	}
 
-	return cf
+	// Every character type lies below 0xff, so c cannot overflow.
+	alphabet := make([]byte, 0, int(max-min)+1)
+	for c := min; c <= max; c++ {
+		if cf.charType == ASCIIAlphanumeric && '9' < c && c < 'A' {
+			continue
+		}
+
+		alphabet = append(alphabet, c)
+	}
+
+	cf.alphabet = string(alphabet)
+	return cf, nil
+}
+
+// NewCharFmtFromAlphabet returns a character format over the bytes of an
+// alphabet, in order. The alphabet must be non-empty and may not repeat a
+// byte. Alphabets of multi-byte characters are not supported here; see
+// the Alphabet type of the parent package.
+func NewCharFmtFromAlphabet(alphabet string) (CharFmt, error) {
+	if alphabet == "" {
+		return CharFmt{}, fmt.Errorf("%w: empty alphabet", ErrInvalidRange)
+	}
+
+	var seen [256]bool
+	for i := 0; i < len(alphabet); i++ {
+		if seen[alphabet[i]] {
+			return CharFmt{}, fmt.Errorf("%w: repeated character %q at offset %d", ErrInvalidCharType, alphabet[i], i)
+		}
+
+		seen[alphabet[i]] = true
+	}
+
+	cf := CharFmt{
+		charType: Custom,
+		min:      alphabet[0],
+		max:      alphabet[len(alphabet)-1],
+		alphabet: alphabet,
+	}
+
+	return cf, nil
+}
+
+// Alphabet returns the characters of the character format in order.
+func (cf CharFmt) Alphabet() string {
+	return cf.alphabet
+}
+
+// Index returns the position of a character in the alphabet of the
+// character format, or -1 if it is not present.
+func (cf CharFmt) Index(char byte) int {
+	return strings.IndexByte(cf.alphabet, char)
 }
 
 // IncrementOrder ... The ith value is the next index to increment.
 type IncrementOrder []int
 
+// isValid reports whether an increment order refers only to positions
+// of a field of the given dimension, and to each at most once.
+func (io IncrementOrder) isValid(dims int) bool {
+	seen := make([]bool, dims)
+	for _, index := range io {
+		if index < 0 || dims <= index || seen[index] {
+			return false
+		}
+
+		seen[index] = true
+	}
+
+	return true
+}
+
 // MaxChars ...
 type MaxChars Field
 
 // MinChars ...
 type MinChars Field
 
-// SkipFnc ...
+// SkipFnc reports whether a value should be skipped while iterating.
 type SkipFnc func(c Current) bool
 
-// NewBytes ...
+// NewBytes returns a sequence configured by the given options, which may
+// be a Start, Current, End, Format, IncrementOrder, SkipFnc, or
+// Exceptions. It panics if the options do not describe a valid sequence.
 func NewBytes(opts ...interface{}) Bytes {
+	bts, err := TryNewBytes(opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return bts
+}
+
+// TryNewBytes returns a sequence configured by the given options, as
+// NewBytes does. A format is required. The increment order defaults to
+// the last index through the first, the start to the first character of
+// each alphabet, the current value to the start, and the end to the last
+// character of each alphabet. Positions absent from the increment order
+// are held at the start. If the current value is skipped, the sequence
+// begins at the next value that is not.
+func TryNewBytes(opts ...interface{}) (Bytes, error) {
 	bts := Bytes{
 		skip: func(c Current) bool { return false },
 	}
@@ -117,81 +241,271 @@ func NewBytes(opts ...interface{}) Bytes {
 			bts.incOrder = t.Copy()
 		case SkipFnc:
 			bts.skip = t
+		case Exceptions:
+			bts.exceptions = t.Copy()
 		default:
-			panic("invalid option type")
+			return Bytes{}, fmt.Errorf("%w: %T", ErrInvalidOption, opt)
 		}
 	}
 
 	if bts.format == nil {
-		panic("format is required")
+		return Bytes{}, ErrFormatRequired
+	}
+
+	if bts.incOrder == nil {
+		bts.incOrder = make(IncrementOrder, 0, bts.dims)
+		for i := bts.dims - 1; 0 <= i; i-- {
+			bts.incOrder = append(bts.incOrder, i)
+		}
+	}
+
+	if !bts.incOrder.isValid(bts.dims) {
+		return Bytes{}, fmt.Errorf("%w: %v", ErrInvalidOrder, []int(bts.incOrder))
 	}
 
 	if bts.start == nil {
 		bts.start = bts.format.Start()
-		// TODO: Increment for any exception characters
 	}
 
 	if bts.current == nil {
 		bts.current = Current(bts.start.Copy())
 	}
 
-	// TODO: compare start to current to end
-	if bts.end == nil {
-		bts.end = bts.format.End()
-		// TODO: Decrement for any exception characters
+	if bts.end == nil && len(bts.start) == bts.dims {
+		// Positions absent from the increment order are held at the start.
+		bts.end = End(bts.start.Copy())
+		for _, index := range bts.incOrder {
+			bts.end[index] = bts.format[index].max
+		}
 	}
 
-	bts.validate()
-	return bts
+	if err := bts.validate(); err != nil {
+		return Bytes{}, err
+	}
+
+	if bts.skipped(Field(bts.current)) {
+		bts.move(0, 1)
+		if bts.skipped(Field(bts.current)) {
+			return Bytes{}, fmt.Errorf("%w: every value is skipped", ErrOutOfRange)
+		}
+
+		bts.overflowed = false
+	}
+
+	return bts, nil
+}
+
+// Decrement moves the sequence back by n values, wrapping from the start
+// to the end, and skips values that are skipped while iterating.
+// Overflowed reports whether it wrapped. A negative n increments.
+func (bts *Bytes) Decrement(n int) {
+	if n < 0 {
+		bts.move(-uint64(n), 1)
+		return
+	}
+
+	bts.move(uint64(n), -1)
+}
+
+// Increment advances the sequence by n values, wrapping from the end to
+// the start, and skips values that are skipped while iterating.
+// Overflowed reports whether it wrapped. A negative n decrements.
+func (bts *Bytes) Increment(n int) {
+	if n < 0 {
+		bts.move(-uint64(n), -1)
+		return
+	}
+
+	bts.move(uint64(n), 1)
+}
+
+// Overflowed reports whether the most recent step wrapped past the end or
+// start of the sequence.
+func (bts *Bytes) Overflowed() bool {
+	return bts.overflowed
+}
+
+// String returns the current value. Numeric positions are written as the
+// digits 0 through 9.
+func (bts Bytes) String() string {
+	b := make([]byte, 0, bts.dims)
+	for i, c := range bts.current {
+		if bts.format[i].charType == Numeric {
+			c += '0'
+		}
+
+		b = append(b, c)
+	}
+
+	return string(b)
 }
 
+// Value returns the current value of the sequence.
+func (bts Bytes) Value() Field {
+	return Field(bts.current).Copy()
+}
+
+// incChar returns the character following char in the alphabet of a
+// character format, wrapping to the first character with a carry of one.
 func incChar(char byte, cf CharFmt) (byte, byte) {
-	switch char {
-	case cf.max:
-		return cf.min, 1
-	default:
-		switch cf.charType {
-		case ASCIIAlphanumeric:
-			if char == '9' {
-				return 'A', 0
-			}
+	i := cf.Index(char) + 1
+	if i == len(cf.alphabet) {
+		return cf.alphabet[0], 1
+	}
 
-			return char + 1, 0
-		default:
-			return char + 1, 0
+	return cf.alphabet[i], 0
+}
+
+// decChar returns the character preceding char in the alphabet of a
+// character format, wrapping to the last character with a borrow of one.
+func decChar(char byte, cf CharFmt) (byte, byte) {
+	i := cf.Index(char) - 1
+	if i < 0 {
+		return cf.alphabet[len(cf.alphabet)-1], 1
+	}
+
+	return cf.alphabet[i], 0
+}
+
+// move moves the current value n values in direction dir, wrapping
+// within the sequence, and then one value at a time in the same direction
+// until it is not skipped. It gives up after visiting every value.
+func (bts *Bytes) move(n uint64, dir int) {
+	fld := Field(bts.current.Copy())
+	bts.overflowed = false
+	switch {
+	case n == 1:
+		bts.overflowed = bts.step(fld, dir)
+	case 1 < n:
+		// Larger moves are made on ordinals, which may exceed 64 bits.
+		var (
+			size = bts.distance(Field(bts.start), Field(bts.end))
+			i    = bts.distance(Field(bts.start), fld)
+			k    = new(big.Int).SetUint64(n)
+		)
+
+		size.Add(size, big.NewInt(1))
+		bts.overflowed = size.Cmp(k) <= 0
+		k.Mod(k, size)
+		if dir < 0 {
+			k.Neg(k)
 		}
+
+		i.Add(i, k)
+		if i.Sign() < 0 || size.Cmp(i) <= 0 {
+			bts.overflowed = true
+		}
+
+		fld = bts.at(i.Mod(i, size))
 	}
+
+	for first := fld.Copy(); bts.skipped(fld); {
+		if bts.step(fld, dir) {
+			bts.overflowed = true
+		}
+
+		if bytes.Equal(fld, first) {
+			break
+		}
+	}
+
+	bts.current = Current(fld)
+}
+
+// step moves a field by one value in direction dir, wrapping from the end
+// to the start or the start to the end, and reports whether it wrapped.
+func (bts *Bytes) step(fld Field, dir int) bool {
+	switch {
+	case 0 < dir && bytes.Equal(fld, Field(bts.end)):
+		copy(fld, bts.start)
+		return true
+	case dir < 0 && bytes.Equal(fld, Field(bts.start)):
+		copy(fld, bts.end)
+		return true
+	case 0 < dir:
+		bts.format.next(fld, bts.incOrder)
+	default:
+		bts.format.prev(fld, bts.incOrder)
+	}
+
+	return false
 }
 
-func (bts *Bytes) increment() {
-	var carry byte
+// at returns the nth value of the sequence.
+func (bts *Bytes) at(n *big.Int) Field {
+	var (
+		fld  = Field(bts.start.Copy())
+		q    = new(big.Int).Set(n)
+		m, t = new(big.Int), new(big.Int)
+	)
+
 	for _, index := range bts.incOrder {
 		var (
-			cf = bts.format[index]
+			cf    = bts.format[index]
+			radix = int64(len(cf.alphabet))
 		)
 
-		bts.current[index], carry = incChar(bts.current[index], cf)
-		if carry != 0 {
+		q.QuoRem(q, t.SetInt64(radix), m)
+		v := int64(cf.Index(fld[index])) + m.Int64()
+		fld[index] = cf.alphabet[v%radix]
+		q.Add(q, t.SetInt64(v/radix))
+	}
+
+	return fld
+}
 
+// skipped reports whether a value contains an exception or is rejected
+// by the skip function.
+func (bts *Bytes) skipped(fld Field) bool {
+	for _, c := range fld {
+		if _, ok := bts.exceptions[c]; ok {
+			return true
 		}
 	}
+
+	return bts.skip(Current(fld))
 }
 
-// TODO
-func (bts *Bytes) validate() {}
+// next advances a field by one, carrying along the increment order, and
+// returns the carry out of the most significant position.
+func (f Format) next(fld Field, incOrder IncrementOrder) byte {
+	var carry byte = 1
+	for _, index := range incOrder {
+		if carry == 0 {
+			break
+		}
 
-// Compare ...
-func (bts Bytes) Compare(b Bytes) int {
-	if bts.dims != b.dims {
-		panic("invalid dimension")
+		fld[index], carry = incChar(fld[index], f[index])
 	}
 
-	for _, index := range bts.incOrder {
-		if bts.format[index].charType != b.format[index].charType {
-			panic("invalid character format")
+	return carry
+}
+
+// prev moves a field back by one, borrowing along the increment order,
+// and returns the borrow from beyond the most significant position.
+func (f Format) prev(fld Field, incOrder IncrementOrder) byte {
+	var borrow byte = 1
+	for _, index := range incOrder {
+		if borrow == 0 {
+			break
 		}
 
-		x, y := bts.current[index], b.current[index]
+		fld[index], borrow = decChar(fld[index], f[index])
+	}
+
+	return borrow
+}
+
+// compare returns -1, 0, or 1 as a precedes, equals, or follows b in the
+// increment order, comparing the most significant position first.
+func (bts *Bytes) compare(a, b Field) int {
+	for i := len(bts.incOrder) - 1; 0 <= i; i-- {
+		var (
+			index = bts.incOrder[i]
+			cf    = bts.format[index]
+			x, y  = cf.Index(a[index]), cf.Index(b[index])
+		)
+
 		switch {
 		case x < y:
 			return -1
@@ -203,6 +517,83 @@ func (bts Bytes) Compare(b Bytes) int {
 	return 0
 }
 
+// contains reports whether a field lies between the start and end. Its
+// positions absent from the increment order must match the start.
+func (bts *Bytes) contains(fld Field) bool {
+	if len(fld) != bts.dims {
+		return false
+	}
+
+	queued := make([]bool, bts.dims)
+	for _, index := range bts.incOrder {
+		queued[index] = true
+	}
+
+	for i, c := range fld {
+		if bts.format[i].Index(c) < 0 || !queued[i] && c != bts.start[i] {
+			return false
+		}
+	}
+
+	return bts.compare(Field(bts.start), fld) <= 0 && bts.compare(fld, Field(bts.end)) <= 0
+}
+
+// validate returns an error unless the start, current, and end values
+// have a character from the alphabet of each position with
+// start <= current <= end.
+func (bts *Bytes) validate() error {
+	for _, fld := range []Field{Field(bts.start), Field(bts.current), Field(bts.end)} {
+		if len(fld) != bts.dims {
+			return fmt.Errorf("%w: %q has %d positions, expected %d", ErrDimensionMismatch, fld, len(fld), bts.dims)
+		}
+
+		for i, c := range fld {
+			if bts.format[i].Index(c) < 0 {
+				return fmt.Errorf("%w: %q at position %d is not in alphabet %q", ErrOutOfRange, c, i, bts.format[i].alphabet)
+			}
+		}
+	}
+
+	if !bts.contains(Field(bts.end)) {
+		return fmt.Errorf("%w: start %q and end %q", ErrInvalidRange, bts.start, bts.end)
+	}
+
+	if !bts.contains(Field(bts.current)) {
+		return fmt.Errorf("%w: current %q", ErrOutOfRange, bts.current)
+	}
+
+	return nil
+}
+
+// Compare returns -1, 0, or 1 as the current value of the sequence
+// precedes, equals, or follows that of another byte sequence with the
+// same format and increment order. It panics if the iterator is not such
+// a sequence.
+func (bts Bytes) Compare(it Iterator) int {
+	b, ok := it.(*Bytes)
+	if !ok {
+		panic("invalid iterator type")
+	}
+
+	if bts.dims != b.dims || len(bts.incOrder) != len(b.incOrder) {
+		panic("invalid dimension")
+	}
+
+	for i, cf := range bts.format {
+		if cf.alphabet != b.format[i].alphabet {
+			panic("invalid character format")
+		}
+	}
+
+	for i, index := range bts.incOrder {
+		if index != b.incOrder[i] {
+			panic("invalid increment order")
+		}
+	}
+
+	return bts.compare(Field(bts.current), Field(b.current))
+}
+
 // Copy ...
 func (s Start) Copy() Start {
 	cpy := make(Start, len(s), cap(s))
@@ -244,6 +635,25 @@ func (f Format) Copy() Format {
 	return cpy
 }
 
+// Parse returns the field spelled by s, which must have a character from
+// the alphabet of each character format.
+func (f Format) Parse(s string) (Field, error) {
+	if len(s) != len(f) {
+		return nil, fmt.Errorf("%w: %q has %d characters, expected %d", ErrInvalidRange, s, len(s), len(f))
+	}
+
+	fld := make(Field, len(s))
+	for i, cf := range f {
+		if cf.Index(s[i]) < 0 {
+			return nil, fmt.Errorf("%w: %q at offset %d is not in alphabet %q", ErrInvalidRange, s[i], i, cf.alphabet)
+		}
+
+		fld[i] = s[i]
+	}
+
+	return fld, nil
+}
+
 // End ...
 func (f Format) End() End {
 	e := make(End, 0, len(f))
@@ -291,5 +701,6 @@ func (cf CharFmt) Copy() CharFmt {
 		charType: cf.charType,
 		min:      cf.min,
 		max:      cf.max,
+		alphabet: cf.alphabet,
 	}
 }
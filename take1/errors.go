@@ -0,0 +1,31 @@
+package sequence
+
+import "errors"
+
+var (
+	// ErrDimensionMismatch is returned when a field does not have a
+	// position for each character format.
+	ErrDimensionMismatch = errors.New("dimension mismatch")
+
+	// ErrFormatRequired is returned when a sequence is built without a
+	// format.
+	ErrFormatRequired = errors.New("format required")
+
+	// ErrInvalidCharType is returned when a range of characters does not
+	// fall within a single character type.
+	ErrInvalidCharType = errors.New("invalid character type")
+
+	// ErrInvalidOption is returned when an option is not of a known type.
+	ErrInvalidOption = errors.New("invalid option")
+
+	// ErrInvalidOrder is returned when an increment order refers to a
+	// position that does not exist or refers to a position twice.
+	ErrInvalidOrder = errors.New("invalid order")
+
+	// ErrInvalidRange is returned when a range is empty.
+	ErrInvalidRange = errors.New("invalid range")
+
+	// ErrOutOfRange is returned when a value lies outside its alphabet or
+	// outside the sequence.
+	ErrOutOfRange = errors.New("out of range")
+)
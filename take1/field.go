@@ -1,10 +1,33 @@
 package sequence
 
-// Field ...
+// Field is a value of a byte sequence.
 type Field []byte
 
-// Compare ...
+// Compare returns -1, 0, or 1 as f precedes, equals, or follows field,
+// comparing the positions of their characters in the alphabets of a
+// format from the first position to the last. It panics if either field
+// does not have a position for each character format.
 func (f Field) Compare(field Field, format Format) int {
+	if len(f) != len(format) || len(field) != len(format) {
+		panic("invalid dimension")
+	}
+
+	for i, cf := range format {
+		x, y := cf.Index(f[i]), cf.Index(field[i])
+		switch {
+		case x < y:
+			return -1
+		case y < x:
+			return 1
+		}
+	}
 
 	return 0
 }
+
+// Copy ...
+func (f Field) Copy() Field {
+	cpy := make(Field, len(f), cap(f))
+	copy(cpy, f)
+	return cpy
+}
@@ -11,35 +11,19 @@ func min(values ...int) int {
 	return m
 }
 
+// addBytes returns the character b places after a in the alphabet of a
+// character format, wrapping past the last character, along with the
+// number of times it wrapped. The character b is taken by its position
+// in the alphabet.
 func addBytes(a, b byte, cf CharFmt) (byte, byte) {
-	if b < a {
-		a, b = b, a
-	}
-
-	if a < cf.min || cf.max < b {
-		// Valid case: cf.min <= a && b <= cf.max
-		panic("index out of range")
-	}
-
-	if cf.charType == ASCIIAlphanumeric {
-		var (
-			c = a + b
-			r byte
-		)
-
-		for ;'9'<c;r++{
-			c-=0
-		}
-	}
-
 	var (
-		c = a + b
-		r byte
+		i, j = cf.Index(a), cf.Index(b)
+		n    = len(cf.alphabet)
 	)
 
-	for ; cf.max < c; r++ {
-		c -= cf.max
+	if i < 0 || j < 0 {
+		panic("index out of range")
 	}
 
-	return c, r
+	return cf.alphabet[(i+j)%n], byte((i + j) / n)
 }
@@ -0,0 +1,90 @@
+package sequence
+
+import (
+	"bytes"
+	"iter"
+	"math/big"
+)
+
+// All returns an iterator over the values from the start through the end
+// of the sequence, with their indices counted from the start. Skipped
+// values are counted but not yielded. The current value is unchanged.
+// Indices wrap modulo 2^64 in sequences of more values.
+func (bts Bytes) All() iter.Seq2[uint64, Field] {
+	return bts.walk(Field(bts.start), Field(bts.end), 0)
+}
+
+// Backward returns an iterator over the values from the end through the
+// start of the sequence, as All does in reverse.
+func (bts Bytes) Backward() iter.Seq2[uint64, Field] {
+	first, last := Field(bts.start), Field(bts.end)
+	return bts.walk(last, first, bts.distance(first, last).Uint64())
+}
+
+// Between returns an iterator over the values from a through b, as All
+// does, with indices that wrap as they do there. If b precedes a, the
+// values are in reverse. Nothing is yielded if a or b does not lie in the
+// sequence.
+func (bts Bytes) Between(a, b Field) iter.Seq2[uint64, Field] {
+	if !bts.contains(a) || !bts.contains(b) {
+		return func(yield func(uint64, Field) bool) {}
+	}
+
+	return bts.walk(a.Copy(), b.Copy(), bts.distance(Field(bts.start), a).Uint64())
+}
+
+// Values returns an iterator over the values from the start through the
+// end of the sequence, as All does without indices.
+func (bts Bytes) Values() iter.Seq[Field] {
+	return func(yield func(Field) bool) {
+		for _, fld := range bts.All() {
+			if !yield(fld) {
+				return
+			}
+		}
+	}
+}
+
+// walk returns an iterator over the values from a through b, which must
+// lie in the sequence, where n is the index of a.
+func (bts Bytes) walk(a, b Field, n uint64) iter.Seq2[uint64, Field] {
+	return func(yield func(uint64, Field) bool) {
+		var (
+			fld  = a.Copy()
+			back = 0 < bts.compare(a, b)
+		)
+
+		for {
+			if !bts.skipped(fld) && !yield(n, fld.Copy()) || bytes.Equal(fld, b) {
+				return
+			}
+
+			if back {
+				bts.format.prev(fld, bts.incOrder)
+				n--
+			} else {
+				bts.format.next(fld, bts.incOrder)
+				n++
+			}
+		}
+	}
+}
+
+// distance returns the number of values from a to b, where a does not
+// follow b.
+func (bts Bytes) distance(a, b Field) *big.Int {
+	var (
+		n     = new(big.Int)
+		radix = big.NewInt(1)
+		t     = new(big.Int)
+	)
+
+	for _, index := range bts.incOrder {
+		cf := bts.format[index]
+		t.SetInt64(int64(cf.Index(b[index]) - cf.Index(a[index])))
+		n.Add(n, t.Mul(t, radix))
+		radix.Mul(radix, t.SetInt64(int64(len(cf.alphabet))))
+	}
+
+	return n
+}
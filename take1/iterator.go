@@ -1,8 +1,15 @@
 package sequence
 
-// Iterator ...
+import "iter"
+
+// Iterator is a sequence that may be stepped through or ranged over.
 type Iterator interface {
 	Increment(n int)
 	Decrement(n int)
 	Compare(iterator Iterator) int
+
+	// All and Backward range over every value of the sequence, with
+	// their indices, without moving it.
+	All() iter.Seq2[uint64, Field]
+	Backward() iter.Seq2[uint64, Field]
 }
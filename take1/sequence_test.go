@@ -1,7 +1,9 @@
 package sequence
 
 import (
-	"fmt"
+	"errors"
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -14,6 +16,309 @@ func TestNew(t *testing.T) {
 		)
 	)
 
-	fmt.Printf("%v", f)
-	t.Fatal()
+	bts := NewBytes(f)
+	if s := bts.String(); s != "101" {
+		t.Fatalf("\nexpected %q\nreceived %q\n", "101", s)
+	}
+
+	tests := []struct {
+		n   int
+		exp string
+		ovf bool
+	}{
+		{n: 1, exp: "102"},
+		{n: 1, exp: "111"},
+		{n: 18, exp: "201"},
+		{n: -3, exp: "182"},
+		{n: 140, exp: "882"},
+		{n: 3, exp: "101", ovf: true},
+		{n: -2, exp: "891", ovf: true},
+		{n: 160, exp: "891", ovf: true},
+		{n: 0, exp: "891"},
+	}
+
+	for _, test := range tests {
+		bts.Increment(test.n)
+		if s := bts.String(); s != test.exp || bts.Overflowed() != test.ovf {
+			t.Fatalf("\nexpected %q, %t\nreceived %q, %t\n", test.exp, test.ovf, s, bts.Overflowed())
+		}
+	}
+
+	bts.Decrement(158)
+	if s := bts.String(); s != "101" || bts.Overflowed() {
+		t.Fatalf("\nexpected %q\nreceived %q\n", "101", s)
+	}
+}
+
+func TestTryNewBytes(t *testing.T) {
+	f := NewFormat(NewCharFmt('A', 'C', 0), NewCharFmt('0', '9', 0))
+	tests := []struct {
+		opts []interface{}
+		err  error
+	}{
+		{opts: []interface{}{f, Start("B0"), Current("A9")}, err: ErrOutOfRange},
+		{opts: []interface{}{f, Start("B0"), End("A9")}, err: ErrInvalidRange},
+		{opts: []interface{}{f, Start("B"), End("C9")}, err: ErrDimensionMismatch},
+		{opts: []interface{}{f, Start("a0")}, err: ErrOutOfRange},
+		{opts: []interface{}{f, IncrementOrder{1, 1}}, err: ErrInvalidOrder},
+		{opts: []interface{}{f, IncrementOrder{2}}, err: ErrInvalidOrder},
+		{opts: []interface{}{f, IncrementOrder{1}, End("C9")}, err: ErrInvalidRange},
+		{opts: []interface{}{Start("A0")}, err: ErrFormatRequired},
+		{opts: []interface{}{f, 3}, err: ErrInvalidOption},
+		{opts: []interface{}{f, Exceptions{'A': {}, 'B': {}, 'C': {}}}, err: ErrOutOfRange},
+		{opts: []interface{}{f, IncrementOrder{1}, Start("B0"), End("B9")}},
+	}
+
+	for _, test := range tests {
+		if _, err := TryNewBytes(test.opts...); !errors.Is(err, test.err) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", test.err, err)
+		}
+	}
+}
+
+func TestBytesSkip(t *testing.T) {
+	f := NewFormat(NewCharFmt('A', 'C', 0), NewCharFmt('0', '9', 0))
+	bts := NewBytes(
+		f,
+		Exceptions{'B': {}},
+		SkipFnc(func(c Current) bool { return c[1] == '5' }),
+	)
+
+	if s := bts.String(); s != "A0" {
+		t.Fatalf("\nexpected %q\nreceived %q\n", "A0", s)
+	}
+
+	var vs []string
+	for i := 0; i < 19; i++ {
+		bts.Increment(1)
+		vs = append(vs, bts.String())
+	}
+
+	exp := "A1 A2 A3 A4 A6 A7 A8 A9 C0 C1 C2 C3 C4 C6 C7 C8 C9 A0 A1"
+	if s := strings.Join(vs, " "); s != exp {
+		t.Fatalf("\nexpected %s\nreceived %s\n", exp, s)
+	}
+
+	// Skipped values are counted when stepping by more than one.
+	bts.Decrement(3)
+	if s := bts.String(); s != "C8" || !bts.Overflowed() {
+		t.Fatalf("\nexpected %q, true\nreceived %q, %t\n", "C8", s, bts.Overflowed())
+	}
+
+	var n int
+	for range bts.All() {
+		n++
+	}
+
+	if n != 18 {
+		t.Fatalf("\nexpected 18 values\nreceived %d\n", n)
+	}
+
+	if bts, _ := TryNewBytes(f, Current("B5"), Exceptions{'B': {}}); bts.String() != "C0" {
+		t.Fatalf("\nexpected %q\nreceived %q\n", "C0", bts.String())
+	}
+}
+
+func TestBytesCompare(t *testing.T) {
+	f := NewFormat(NewCharFmt('A', 'C', 0), NewCharFmt('0', '9', 0))
+	tests := []struct {
+		a, b     string
+		order    IncrementOrder
+		exp, fld int
+	}{
+		{a: "A9", b: "B0", order: IncrementOrder{1, 0}, exp: -1, fld: -1},
+		{a: "B0", b: "A9", order: IncrementOrder{1, 0}, exp: 1, fld: 1},
+		{a: "A9", b: "B0", order: IncrementOrder{0, 1}, exp: 1, fld: -1},
+		{a: "C5", b: "C5", order: IncrementOrder{0, 1}, exp: 0, fld: 0},
+	}
+
+	for _, test := range tests {
+		a := NewBytes(f, test.order, Current(test.a))
+		b := NewBytes(f, test.order, Current(test.b))
+		if c := a.Compare(&b); c != test.exp {
+			t.Fatalf("\ngiven %s, %s, %v\nexpected %d\nreceived %d\n", test.a, test.b, test.order, test.exp, c)
+		}
+
+		if c := Field(test.a).Compare(Field(test.b), f); c != test.fld {
+			t.Fatalf("\ngiven %s, %s\nexpected %d\nreceived %d\n", test.a, test.b, test.fld, c)
+		}
+	}
+}
+
+func TestBytesWide(t *testing.T) {
+	cf, err := NewCharFmtFromAlphabet("0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, wide := range []struct {
+		dims    int
+		wrapped string
+	}{
+		{dims: 16, wrapped: "8000000000000001"},
+		{dims: 17, wrapped: "f8000000000000001"},
+	} {
+		dims := wide.dims
+		cfs := make([]CharFmt, dims)
+		for i := range cfs {
+			cfs[i] = cf
+		}
+
+		var (
+			zero  = strings.Repeat("0", dims)
+			bts   = NewBytes(NewFormat(cfs...))
+			tests = []struct {
+				n        int
+				exp      string
+				overflow bool
+			}{
+				{n: 1, exp: zero[1:] + "1"},
+				{n: -2, exp: strings.Repeat("f", dims), overflow: true},
+				{n: 1, exp: zero, overflow: true},
+				{n: math.MaxInt, exp: zero[16:] + "7fffffffffffffff"},
+				{n: -math.MaxInt, exp: zero},
+				{n: -math.MaxInt, exp: wide.wrapped, overflow: true},
+			}
+		)
+
+		for _, test := range tests {
+			bts.Increment(test.n)
+			if s := bts.String(); s != test.exp || bts.Overflowed() != test.overflow {
+				t.Fatalf("\ngiven %d positions, %d\nexpected %q, %t\nreceived %q, %t\n", dims, test.n, test.exp, test.overflow, s, bts.Overflowed())
+			}
+		}
+	}
+}
+
+func TestTryNewCharFmt(t *testing.T) {
+	tests := []struct {
+		min, max byte
+		err      error
+	}{
+		{min: 0, max: 9},
+		{min: '0', max: 'Z'},
+		{min: 'B', max: 'A', err: ErrInvalidRange},
+		{min: 'a', max: 'z', err: ErrInvalidCharType},
+	}
+
+	for _, test := range tests {
+		if _, err := TryNewCharFmt(test.min, test.max, 0); !errors.Is(err, test.err) {
+			t.Fatalf("\ngiven [%d,%d]\nexpected %v\nreceived %v\n", test.min, test.max, test.err, err)
+		}
+	}
+}
+
+func TestCharFmtAlphabet(t *testing.T) {
+	crockford, err := NewCharFmtFromAlphabet(Crockford32)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		cf          CharFmt
+		char        byte
+		inc, dec    byte
+		carry, borr byte
+	}{
+		{cf: NewCharFmt('0', 'Z', 0), char: '9', inc: 'A', dec: '8'},
+		{cf: NewCharFmt('0', 'Z', 0), char: 'A', inc: 'B', dec: '9'},
+		{cf: NewCharFmt('0', 'Z', 0), char: 'Z', inc: '0', dec: 'Y', carry: 1},
+		{cf: NewCharFmt(0, 9, 0), char: 0, inc: 1, dec: 9, borr: 1},
+		{cf: crockford, char: 'H', inc: 'J', dec: 'G'},
+		{cf: crockford, char: 'T', inc: 'V', dec: 'S'},
+		{cf: crockford, char: '0', inc: '1', dec: 'Z', borr: 1},
+	}
+
+	for _, test := range tests {
+		if inc, carry := incChar(test.char, test.cf); inc != test.inc || carry != test.carry {
+			t.Fatalf("\nexpected %q after %q\nreceived %q, %d\n", test.inc, test.char, inc, carry)
+		}
+
+		if dec, borrow := decChar(test.char, test.cf); dec != test.dec || borrow != test.borr {
+			t.Fatalf("\nexpected %q before %q\nreceived %q, %d\n", test.dec, test.char, dec, borrow)
+		}
+	}
+
+	if c, k := addBytes('Z', '3', crockford); c != '2' || k != 1 {
+		t.Fatalf("\nexpected ('2',1)\nreceived (%q,%d)\n", c, k)
+	}
+
+	hex, _ := NewCharFmtFromAlphabet(HexLower)
+	f := NewFormat(crockford, hex)
+	if fld, err := f.Parse("Zf"); err != nil || string(fld) != "Zf" {
+		t.Fatalf("\nexpected %q\nreceived %q, %v\n", "Zf", fld, err)
+	}
+
+	for _, s := range []string{"Uf", "ZF", "Z"} {
+		if _, err := f.Parse(s); !errors.Is(err, ErrInvalidRange) {
+			t.Fatalf("\ngiven %q\nexpected %v\nreceived %v\n", s, ErrInvalidRange, err)
+		}
+	}
+
+	if _, err := NewCharFmtFromAlphabet("ABA"); !errors.Is(err, ErrInvalidCharType) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidCharType, err)
+	}
+}
+
+func TestIter(t *testing.T) {
+	hex, _ := NewCharFmtFromAlphabet(HexUpper)
+	bts := NewBytes(
+		NewFormat(NewCharFmt('A', 'C', 0), NewCharFmt('0', '9', 0), hex),
+		IncrementOrder{2, 0},
+		Start("A5E"),
+		End("C51"),
+	)
+
+	var (
+		vs []string
+		ns []uint64
+	)
+
+	for n, fld := range bts.All() {
+		vs, ns = append(vs, string(fld)), append(ns, n)
+	}
+
+	if len(vs) != 20 || vs[0] != "A5E" || vs[1] != "A5F" || vs[2] != "B50" || vs[19] != "C51" || ns[19] != 19 {
+		t.Fatalf("\nexpected A5E through C51\nreceived %v\n", vs)
+	}
+
+	i := len(vs)
+	for n, fld := range bts.Backward() {
+		i--
+		if string(fld) != vs[i] || n != ns[i] {
+			t.Fatalf("\nexpected %d: %s\nreceived %d: %s\n", ns[i], vs[i], n, fld)
+		}
+	}
+
+	if i != 0 {
+		t.Fatalf("\nexpected %d values\nreceived %d\n", len(vs), len(vs)-i)
+	}
+
+	vs = vs[:0]
+	for n, fld := range bts.Between(Field("B53"), Field("A5F")) {
+		if n == 5 && string(fld) != "B53" {
+			t.Fatalf("\nexpected 5: B53\nreceived %d: %s\n", n, fld)
+		}
+
+		vs = append(vs, string(fld))
+	}
+
+	if len(vs) != 5 || vs[4] != "A5F" {
+		t.Fatalf("\nexpected B53 through A5F\nreceived %v\n", vs)
+	}
+
+	for range bts.Between(Field("A50"), Field("B50")) {
+		t.Fatal("\nexpected no values\n")
+	}
+
+	var count int
+	for range bts.Values() {
+		if count++; count == 3 {
+			break
+		}
+	}
+
+	if count != 3 || string(bts.current) != "A5E" {
+		t.Fatalf("\nexpected to stop at 3 leaving A5E\nreceived %d leaving %s\n", count, bts.current)
+	}
 }
@@ -0,0 +1,251 @@
+package sequence
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseError describes text that does not represent a value of a
+// sequence.
+type ParseError struct {
+	// Text is the text being parsed.
+	Text string
+
+	// Offset is the byte offset into the text at which the error was
+	// found.
+	Offset int
+
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse %q at offset %d: %v", e.Text, e.Offset, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// WithAlphabets sets the alphabet each position is written in. It
+// defaults to the alphabets of the pattern, if any, or to Digits.
+func WithAlphabets(alphabets ...Alphabet) Option {
+	return func(its *Ints) {
+		its.alphabets = make([]Alphabet, len(alphabets))
+		copy(its.alphabets, alphabets)
+	}
+}
+
+// Format returns the text of a value. Each position is written in its
+// alphabet, padded with the first character of the alphabet to the width
+// of its maximum, and the literal text of the pattern, if any, is placed
+// between positions.
+func (its *Ints) Format(v []int) (string, error) {
+	if err := its.validate(v); err != nil {
+		return "", err
+	}
+
+	var spans [][2]int
+	if its.checkDigit != nil {
+		spans = make([][2]int, its.dims)
+	}
+
+	s := its.render(v, spans)
+	if its.checkDigit != nil {
+		c, err := its.checkDigit.Compute(payload(s, spans))
+		if err != nil {
+			return "", err
+		}
+
+		s += string(c)
+	}
+
+	return s, nil
+}
+
+// Parse returns the value represented by text written as by Format. If
+// the sequence has a check digit scheme, the check character must match.
+func (its *Ints) Parse(s string) ([]int, error) {
+	if its.checkDigit == nil {
+		return its.parse(s, s)
+	}
+
+	r, w := utf8.DecodeLastRuneInString(s)
+	if w == 0 {
+		return nil, &ParseError{Text: s, Offset: 0, Err: fmt.Errorf("%w: missing check character", ErrSyntax)}
+	}
+
+	v, err := its.parse(s, s[:len(s)-w])
+	if err != nil {
+		return nil, err
+	}
+
+	// The check character is computed over the canonical text, so aliases
+	// of characters are accepted.
+	spans := make([][2]int, its.dims)
+	c, err := its.checkDigit.Compute(payload(its.render(v, spans), spans))
+	if err != nil {
+		return nil, &ParseError{Text: s, Offset: 0, Err: err}
+	}
+
+	if !checkMatches(its.checkDigit, r, c) {
+		return nil, &ParseError{Text: s, Offset: len(s) - w, Err: fmt.Errorf("%w: expected %q, received %q", ErrCheckDigit, c, r)}
+	}
+
+	return v, nil
+}
+
+// parse returns the value represented by the text s, which is a prefix
+// of the full text being parsed.
+func (its *Ints) parse(text, s string) ([]int, error) {
+	var (
+		v = make([]int, its.dims)
+		j int
+	)
+
+	for i := range v {
+		if its.literals != nil {
+			if !strings.HasPrefix(s[j:], its.literals[i]) {
+				return nil, &ParseError{Text: text, Offset: j, Err: fmt.Errorf("%w: expected %q", ErrSyntax, its.literals[i])}
+			}
+
+			j += len(its.literals[i])
+		}
+
+		a, start := its.alphabets[i], j
+		for k := 0; k < its.widths[i]; k++ {
+			r, w := utf8.DecodeRuneInString(s[j:])
+			if w == 0 {
+				return nil, &ParseError{Text: text, Offset: j, Err: fmt.Errorf("%w: unexpected end of text", ErrSyntax)}
+			}
+
+			d, ok := a.Index(r)
+			if !ok {
+				return nil, &ParseError{Text: text, Offset: j, Err: fmt.Errorf("%w: %q is not in alphabet %q", ErrSyntax, r, a)}
+			}
+
+			v[i] = v[i]*a.Len() + d
+			j += w
+		}
+
+		if bf := its.format[i]; !bf.contains(v[i]) {
+			return nil, &ParseError{Text: text, Offset: start, Err: fmt.Errorf("%w: position %d has value %d outside [%d,%d]", ErrInvalidRange, i, v[i], bf.min, bf.max)}
+		}
+	}
+
+	if its.literals != nil {
+		if s[j:] != its.literals[its.dims] {
+			return nil, &ParseError{Text: text, Offset: j, Err: fmt.Errorf("%w: expected %q", ErrSyntax, its.literals[its.dims])}
+		}
+
+		j = len(s)
+	}
+
+	if j != len(s) {
+		return nil, &ParseError{Text: text, Offset: j, Err: fmt.Errorf("%w: unexpected trailing text", ErrSyntax)}
+	}
+
+	return v, nil
+}
+
+// String returns the text of the current value.
+func (its *Ints) String() string {
+	s, err := its.Format([]int(its.current))
+	if err != nil {
+		return fmt.Sprint([]int(its.current))
+	}
+
+	return s
+}
+
+// payload returns the text of the positions of a rendered value,
+// omitting literal text.
+func payload(text string, spans [][2]int) string {
+	var b strings.Builder
+	for _, span := range spans {
+		b.WriteString(text[span[0]:span[1]])
+	}
+
+	return b.String()
+}
+
+// render returns the text of a valid field. If spans is not nil, the
+// byte offsets [start,end) of each position within the text are written
+// to it.
+func (its *Ints) render(f field, spans [][2]int) string {
+	var (
+		b      strings.Builder
+		digits []rune
+	)
+
+	for i, x := range f {
+		if its.literals != nil {
+			b.WriteString(its.literals[i])
+		}
+
+		a := its.alphabets[i]
+		digits = digits[:0]
+		for j := 0; j < its.widths[i]; j++ {
+			r, _ := a.Rune(x % a.Len())
+			digits = append(digits, r)
+			x /= a.Len()
+		}
+
+		if spans != nil {
+			spans[i][0] = b.Len()
+		}
+
+		for j := len(digits) - 1; 0 <= j; j-- {
+			b.WriteRune(digits[j])
+		}
+
+		if spans != nil {
+			spans[i][1] = b.Len()
+		}
+	}
+
+	if its.literals != nil {
+		b.WriteString(its.literals[its.dims])
+	}
+
+	return b.String()
+}
+
+// layout sets the alphabets and widths positions are written with,
+// returning an error if a position cannot be written in its alphabet.
+func (its *Ints) layout() error {
+	if its.alphabets == nil {
+		its.alphabets = make([]Alphabet, its.dims)
+		for i := range its.alphabets {
+			its.alphabets[i] = Digits
+		}
+	}
+
+	if len(its.alphabets) != its.dims {
+		return &DimensionError{Expected: its.dims, Received: len(its.alphabets)}
+	}
+
+	its.widths = make([]int, its.dims)
+	for i, a := range its.alphabets {
+		n, max := a.Len(), its.format[i].max
+		switch {
+		case n == 0:
+			return fmt.Errorf("%w: position %d has an empty alphabet", ErrInvalidRange, i)
+		case n == 1 && max != 0:
+			return fmt.Errorf("%w: position %d has maximum %d but alphabet %q", ErrInvalidRange, i, max, a)
+		}
+
+		its.widths[i] = 1
+		for p := n; n != 1 && p <= max; p *= n {
+			its.widths[i]++
+			if max/n < p {
+				// The next power would exceed max, and may overflow.
+				break
+			}
+		}
+	}
+
+	return nil
+}
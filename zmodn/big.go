@@ -0,0 +1,59 @@
+package zmodn
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigInt returns x as a big.Int.
+func (x *Z) BigInt() *big.Int {
+	var (
+		z = new(big.Int)
+		n = big.NewInt(int64(x.modulus))
+		t = new(big.Int)
+	)
+
+	for i := len(x.value) - 1; 0 <= i; i-- {
+		z.Mul(z, n)
+		z.Add(z, t.SetInt64(int64(x.value[i])))
+	}
+
+	if x.negative {
+		z.Neg(z)
+	}
+
+	return z
+}
+
+// FromBigInt returns v in base modulus. It panics if the modulus is less
+// than two.
+func FromBigInt(v *big.Int, modulus int) *Z {
+	x, err := TryFromBigInt(v, modulus)
+	if err != nil {
+		panic(err)
+	}
+
+	return x
+}
+
+// TryFromBigInt returns v in base modulus. The modulus must be at least
+// two.
+func TryFromBigInt(v *big.Int, modulus int) (*Z, error) {
+	if modulus < 2 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidModulus, modulus)
+	}
+
+	var (
+		x = &Z{modulus: modulus, negative: v.Sign() < 0}
+		q = new(big.Int).Abs(v)
+		n = big.NewInt(int64(modulus))
+		r = new(big.Int)
+	)
+
+	for q.Sign() != 0 {
+		q.QuoRem(q, n, r)
+		x.value = append(x.value, int(r.Int64()))
+	}
+
+	return x, nil
+}
@@ -0,0 +1,73 @@
+package zmodn
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestBigInt(t *testing.T) {
+	v, _ := new(big.Int).SetString("-123456789012345678901234567890123456789", 10)
+	for _, n := range []int{2, 3, 10, 36, 1 << 16} {
+		x := FromBigInt(v, n)
+		if rec := x.BigInt(); rec.Cmp(v) != 0 {
+			t.Fatalf("\nexpected %v\nreceived %v\n", v, rec)
+		}
+
+		y := Add(x, One(n))
+		if x.Compare(y) != -1 || y.Compare(x) != 1 || x.Compare(x.Copy()) != 0 {
+			t.Fatalf("\nexpected %v < %v\n", x, y)
+		}
+
+		if z := x.Abs(); x.Compare(z) != -1 || z.Compare(Zero(n)) != 1 || z.Sign() != 1 || x.Sign() != -1 {
+			t.Fatalf("\nexpected %v < 0 < %v\n", x, z)
+		}
+
+		if z := Subtract(x, x); !z.IsZero() || z.IsNegative() || z.IsPositive() || z.Sign() != 0 {
+			t.Fatalf("\nexpected 0\nreceived %v\n", z)
+		}
+	}
+
+	if x := FromBigInt(new(big.Int), 10); !x.IsZero() || x.Negate().IsNegative() {
+		t.Fatalf("\nexpected 0\nreceived %v\n", x)
+	}
+
+	if _, err := TryFromBigInt(big.NewInt(5), 1); !errors.Is(err, ErrInvalidModulus) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidModulus, err)
+	}
+
+	if rec := New(255, 16).Compare(New(256, 2)); rec != -1 {
+		t.Fatalf("\nexpected -1\nreceived %d\n", rec)
+	}
+}
+
+func TestSigns(t *testing.T) {
+	for a := -12; a <= 12; a++ {
+		for b := -12; b <= 12; b++ {
+			x, y := New(a, 3), New(b, 3)
+			if rec := Add(x, y); rec.Integer() != a+b || rec.Sign() != sign(a+b) {
+				t.Fatalf("\nexpected %d+%d = %d\nreceived %v\n", a, b, a+b, rec)
+			}
+
+			if rec := Subtract(x, y); rec.Integer() != a-b || rec.Sign() != sign(a-b) {
+				t.Fatalf("\nexpected %d-%d = %d\nreceived %v\n", a, b, a-b, rec)
+			}
+
+			if rec := x.Compare(y); rec != sign(a-b) {
+				t.Fatalf("\nexpected %d\nreceived %d\n", sign(a-b), rec)
+			}
+		}
+	}
+}
+
+// sign returns -1, 0, or 1 as n is negative, zero, or positive.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case 0 < n:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,85 @@
+package zmodn
+
+import "fmt"
+
+// ToBase returns x in base m. It panics if m is less than two.
+func (x *Z) ToBase(m int) *Z {
+	y, err := x.TryToBase(m)
+	if err != nil {
+		panic(err)
+	}
+
+	return y
+}
+
+// TryToBase returns x in base m. The modulus m must be at least two.
+func (x *Z) TryToBase(m int) (*Z, error) {
+	if m < 2 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidModulus, m)
+	}
+
+	return x.toBase(m), nil
+}
+
+// toBase returns x in base m, evaluating its digits from most to least
+// significant by Horner's method in base m.
+func (x *Z) toBase(m int) *Z {
+	if x.modulus == m {
+		return x.Copy()
+	}
+
+	var (
+		n = newZ(x.modulus, m).value
+		v []int
+	)
+
+	for i := len(x.value) - 1; 0 <= i; i-- {
+		v = addDigits(multiplyDigits(v, n, m), newZ(x.value[i], m).value, m)
+	}
+
+	y := &Z{value: v, modulus: m, negative: x.negative}
+	y.clean()
+	return y
+}
+
+// Mixed is the mixed-modulus arithmetic mode. Where the package functions
+// return a ModulusError, its operations convert the right operand to the
+// modulus of the left and return results in that modulus.
+type Mixed struct{}
+
+// Add returns x+y.
+func (Mixed) Add(x, y *Z) *Z {
+	return add(x, y.toBase(x.modulus))
+}
+
+// Subtract returns x-y.
+func (Mixed) Subtract(x, y *Z) *Z {
+	return subtract(x, y.toBase(x.modulus))
+}
+
+// Multiply returns xy.
+func (Mixed) Multiply(x, y *Z) *Z {
+	return multiply(x, y.toBase(x.modulus))
+}
+
+// Div returns the quotient of x/y. It panics if y is zero.
+func (Mixed) Div(x, y *Z) *Z {
+	return Div(x, y.toBase(x.modulus))
+}
+
+// DivMod returns the quotient and remainder of x/y. It panics if y is
+// zero.
+func (Mixed) DivMod(x, y *Z) (*Z, *Z) {
+	return DivMod(x, y.toBase(x.modulus))
+}
+
+// Mod returns the remainder of x/y. It panics if y is zero.
+func (Mixed) Mod(x, y *Z) *Z {
+	return Mod(x, y.toBase(x.modulus))
+}
+
+// QuoRem returns the quotient of x/y truncated toward zero and the
+// remainder x-qy. It panics if y is zero.
+func (Mixed) QuoRem(x, y *Z) (*Z, *Z) {
+	return QuoRem(x, y.toBase(x.modulus))
+}
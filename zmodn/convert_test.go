@@ -0,0 +1,64 @@
+package zmodn
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestToBase(t *testing.T) {
+	v, _ := new(big.Int).SetString("-98765432109876543210987654321098765432109876543210", 10)
+	for _, n := range []int{2, 3, 10, 36, 1 << 20} {
+		x := FromBigInt(v, n)
+		for _, m := range []int{2, 7, 10, 36, 1 << 20} {
+			y := x.ToBase(m)
+			if exp := FromBigInt(v, m); y.modulus != m || !equalDigits(y.value, exp.value) || y.negative != exp.negative {
+				t.Fatalf("\nexpected %v\nreceived %v\n", exp, y)
+			}
+		}
+	}
+
+	if rec := New(35, 36).ToBase(2); rec.Integer() != 35 || rec.modulus != 2 {
+		t.Fatalf("\nexpected 35 (base 2)\nreceived %v\n", rec)
+	}
+
+	if rec := Zero(36).ToBase(10); !rec.IsZero() {
+		t.Fatalf("\nexpected 0\nreceived %v\n", rec)
+	}
+
+	if _, err := New(5, 10).TryToBase(1); !errors.Is(err, ErrInvalidModulus) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidModulus, err)
+	}
+}
+
+func TestMixed(t *testing.T) {
+	var (
+		mx   Mixed
+		x, y = New(100, 36), New(-7, 2)
+	)
+
+	tests := []struct {
+		z   *Z
+		exp int
+	}{
+		{z: mx.Add(x, y), exp: 93},
+		{z: mx.Subtract(x, y), exp: 107},
+		{z: mx.Multiply(x, y), exp: -700},
+		{z: mx.Div(x, y), exp: -15},
+		{z: mx.Mod(x, y), exp: -5},
+	}
+
+	for _, test := range tests {
+		if test.z.Integer() != test.exp || test.z.modulus != 36 {
+			t.Fatalf("\nexpected %d (base 36)\nreceived %v\n", test.exp, test.z)
+		}
+	}
+
+	if q, r := mx.QuoRem(x, y); q.Integer() != -14 || r.Integer() != 2 {
+		t.Fatalf("\nexpected -14, 2\nreceived %v, %v\n", q, r)
+	}
+
+	if _, err := TryAdd(x, y); !errors.Is(err, ErrModulusMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrModulusMismatch, err)
+	}
+}
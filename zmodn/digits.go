@@ -0,0 +1,201 @@
+package zmodn
+
+// The functions here operate on magnitudes: slices of digits in base n,
+// from least to most significant, each in [0,n). Results are trimmed of
+// leading zeros, so zero is the empty slice.
+
+// karatsubaThreshold is the number of digits of the shorter factor above
+// which multiplication splits its factors.
+const karatsubaThreshold = 32
+
+// addDigits returns a+b.
+func addDigits(a, b []int, n int) []int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	var (
+		c     = make([]int, 0, len(a)+1)
+		carry int
+	)
+
+	for i, v := range a {
+		v += carry
+		if i < len(b) {
+			v += b[i]
+		}
+
+		carry = 0
+		if n <= v {
+			v -= n
+			carry = 1
+		}
+
+		c = append(c, v)
+	}
+
+	if carry != 0 {
+		c = append(c, carry)
+	}
+
+	return c
+}
+
+// subtractDigits returns a-b. The magnitude a must not be less than b.
+func subtractDigits(a, b []int, n int) []int {
+	var (
+		c      = make([]int, 0, len(a))
+		borrow int
+	)
+
+	for i, v := range a {
+		v -= borrow
+		if i < len(b) {
+			v -= b[i]
+		}
+
+		borrow = 0
+		if v < 0 {
+			v += n
+			borrow = 1
+		}
+
+		c = append(c, v)
+	}
+
+	return trimDigits(c)
+}
+
+// multiplyDigits returns ab, using Karatsuba's method if both factors
+// are long and long multiplication otherwise.
+func multiplyDigits(a, b []int, n int) []int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	if len(b) <= karatsubaThreshold {
+		return longMultiplyDigits(a, b, n)
+	}
+
+	// Split both factors at m digits: a = a1 n^m + a0 and b = b1 n^m + b0.
+	// Then ab = z2 n^2m + z1 n^m + z0, where z2 = a1 b1, z0 = a0 b0, and
+	// z1 = (a0+a1)(b0+b1) - z2 - z0.
+	var (
+		m      = len(b) / 2
+		a0, a1 = trimDigits(a[:m]), a[m:]
+		b0, b1 = trimDigits(b[:m]), b[m:]
+		z2     = multiplyDigits(a1, b1, n)
+		z0     = multiplyDigits(a0, b0, n)
+		z1     = multiplyDigits(addDigits(a0, a1, n), addDigits(b0, b1, n), n)
+	)
+
+	z1 = subtractDigits(subtractDigits(z1, z2, n), z0, n)
+	return addDigits(addDigits(shiftDigits(z2, 2*m), shiftDigits(z1, m), n), z0, n)
+}
+
+// longMultiplyDigits returns ab by long multiplication.
+func longMultiplyDigits(a, b []int, n int) []int {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+
+	c := make([]int, len(a)+len(b))
+	for i, u := range a {
+		if u == 0 {
+			continue
+		}
+
+		var carry int
+		for j, v := range b {
+			t := c[i+j] + u*v + carry
+			c[i+j], carry = t%n, t/n
+		}
+
+		for k := i + len(b); carry != 0; k++ {
+			t := c[k] + carry
+			c[k], carry = t%n, t/n
+		}
+	}
+
+	return trimDigits(c)
+}
+
+// shiftDigits returns a n^k.
+func shiftDigits(a []int, k int) []int {
+	if len(a) == 0 {
+		return nil
+	}
+
+	c := make([]int, k+len(a))
+	copy(c[k:], a)
+	return c
+}
+
+// trimDigits returns a without its leading zeros.
+func trimDigits(a []int) []int {
+	i := len(a)
+	for 0 < i && a[i-1] == 0 {
+		i--
+	}
+
+	return a[:i]
+}
+
+// compareDigits returns -1, 0, or 1 as a is less than, equal to, or
+// greater than b.
+func compareDigits(a, b []int) int {
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(b) < len(a):
+		return 1
+	}
+
+	for i := len(a) - 1; 0 <= i; i-- {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case b[i] < a[i]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// divideDigits returns the quotient and remainder of a/b by long
+// division. The magnitude b must not be zero.
+func divideDigits(a, b []int, n int) ([]int, []int) {
+	if compareDigits(a, b) < 0 {
+		return nil, a
+	}
+
+	var (
+		q = make([]int, len(a))
+		r []int
+	)
+
+	for i := len(a) - 1; 0 <= i; i-- {
+		// Bring down the next digit, then find the largest digit d with
+		// bd <= r by binary search.
+		r = trimDigits(append([]int{a[i]}, r...))
+
+		lo, hi := 0, n-1
+		for lo < hi {
+			d := hi - (hi-lo)/2
+			if compareDigits(longMultiplyDigits(b, []int{d}, n), r) <= 0 {
+				lo = d
+			} else {
+				hi = d - 1
+			}
+		}
+
+		if lo != 0 {
+			r = subtractDigits(r, longMultiplyDigits(b, []int{lo}, n), n)
+		}
+
+		q[i] = lo
+	}
+
+	return trimDigits(q), r
+}
@@ -0,0 +1,114 @@
+package zmodn
+
+// Division follows euclidsCoeffs: x = qy + r, where 0 <= r < |y| if y is
+// positive and y < r <= 0 if y is negative. QuoRem instead truncates
+// its quotient toward zero, as Go's / and % do.
+
+// Div sets x to the quotient of x/y.
+func (x *Z) Div(y *Z) {
+	q, _, err := TryDivMod(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	*x = *q
+}
+
+// Div returns the quotient of x/y. It panics if x and y have differing
+// moduli or y is zero.
+func Div(x, y *Z) *Z {
+	q, _ := DivMod(x, y)
+	return q
+}
+
+// TryDiv returns the quotient of x/y, or an error if x and y have
+// differing moduli or y is zero.
+func TryDiv(x, y *Z) (*Z, error) {
+	q, _, err := TryDivMod(x, y)
+	return q, err
+}
+
+// DivMod returns the quotient and remainder of x/y. It panics if x and y
+// have differing moduli or y is zero.
+func DivMod(x, y *Z) (*Z, *Z) {
+	q, r, err := TryDivMod(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	return q, r
+}
+
+// TryDivMod returns the quotient and remainder of x/y, or an error if x
+// and y have differing moduli or y is zero.
+func TryDivMod(x, y *Z) (*Z, *Z, error) {
+	q, r, err := TryQuoRem(x, y)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(r.value) != 0 && x.negative != y.negative {
+		// Move the remainder into the range of y: x = (q-1)y + (r+y),
+		// where q is negative and r has the sign of x.
+		q.value = addDigits(q.value, []int{1}, q.modulus)
+		q.negative = true
+		r.value = subtractDigits(y.value, r.value, r.modulus)
+		r.negative = y.negative
+	}
+
+	return q, r, nil
+}
+
+// Mod sets x to the remainder of x/y.
+func (x *Z) Mod(y *Z) {
+	_, r, err := TryDivMod(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	*x = *r
+}
+
+// Mod returns the remainder of x/y. It panics if x and y have differing
+// moduli or y is zero.
+func Mod(x, y *Z) *Z {
+	_, r := DivMod(x, y)
+	return r
+}
+
+// TryMod returns the remainder of x/y, or an error if x and y have
+// differing moduli or y is zero.
+func TryMod(x, y *Z) (*Z, error) {
+	_, r, err := TryDivMod(x, y)
+	return r, err
+}
+
+// QuoRem returns the quotient of x/y truncated toward zero and the
+// remainder x-qy, which has the sign of x. It panics if x and y have
+// differing moduli or y is zero.
+func QuoRem(x, y *Z) (*Z, *Z) {
+	q, r, err := TryQuoRem(x, y)
+	if err != nil {
+		panic(err)
+	}
+
+	return q, r
+}
+
+// TryQuoRem returns the quotient of x/y truncated toward zero and the
+// remainder x-qy, or an error if x and y have differing moduli or y is
+// zero.
+func TryQuoRem(x, y *Z) (*Z, *Z, error) {
+	if err := checkModuli(x, y); err != nil {
+		return nil, nil, err
+	}
+
+	if len(y.value) == 0 {
+		return nil, nil, ErrDivisionByZero
+	}
+
+	qv, rv := divideDigits(x.value, y.value, x.modulus)
+	q := &Z{value: qv, modulus: x.modulus, negative: len(qv) != 0 && x.negative != y.negative}
+	r := &Z{value: append([]int(nil), rv...), modulus: x.modulus, negative: len(rv) != 0 && x.negative}
+	return q, r, nil
+}
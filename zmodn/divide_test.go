@@ -0,0 +1,76 @@
+package zmodn
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestDivMod(t *testing.T) {
+	for _, n := range []int{2, 3, 10, 16} {
+		for a := -30; a <= 30; a++ {
+			for b := -7; b <= 7; b++ {
+				if b == 0 {
+					continue
+				}
+
+				x, y := New(a, n), New(b, n)
+				k, r := euclidsCoeffs(a, b)
+				if q, m := DivMod(x, y); q.Integer() != k || m.Integer() != r || q.IsNegative() != (k < 0) || m.IsNegative() != (r < 0) {
+					t.Fatalf("\nexpected %d, %d\nreceived %v, %v\n", k, r, q, m)
+				}
+
+				if q, m := QuoRem(x, y); q.Integer() != a/b || m.Integer() != a%b {
+					t.Fatalf("\nexpected %d, %d\nreceived %v, %v\n", a/b, a%b, q, m)
+				}
+
+				z := x.Copy()
+				z.Div(y)
+				if z.Integer() != k {
+					t.Fatalf("\nexpected %d\nreceived %v\n", k, z)
+				}
+
+				z = x.Copy()
+				z.Mod(y)
+				if z.Integer() != r {
+					t.Fatalf("\nexpected %d\nreceived %v\n", r, z)
+				}
+			}
+		}
+	}
+}
+
+func TestLongDivision(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 10, 1 << 20} {
+		a, b := make([]int, 100), make([]int, 37)
+		for i := range a {
+			a[i] = r.Intn(n)
+		}
+
+		for i := range b {
+			b[i] = r.Intn(n)
+		}
+
+		b[len(b)-1] = 1 + r.Intn(n-1)
+		a = trimDigits(a)
+
+		q, m := divideDigits(a, b, n)
+		expQ, expM := new(big.Int).QuoRem(bigDigits(a, n), bigDigits(b, n), new(big.Int))
+		if bigDigits(q, n).Cmp(expQ) != 0 || bigDigits(m, n).Cmp(expM) != 0 {
+			t.Fatalf("\nexpected %v, %v\nreceived %v, %v\n", expQ, expM, bigDigits(q, n), bigDigits(m, n))
+		}
+	}
+}
+
+func TestTryDivide(t *testing.T) {
+	x := New(5, 10)
+	if _, err := TryDiv(x, Zero(10)); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrDivisionByZero, err)
+	}
+
+	if _, err := TryMod(x, New(2, 3)); !errors.Is(err, ErrModulusMismatch) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrModulusMismatch, err)
+	}
+}
@@ -0,0 +1,239 @@
+package zmodn
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// encodingVersion is the version written by MarshalBinary and
+// MarshalJSON. Decoding accepts every version up to it.
+const encodingVersion = 1
+
+// jsonZ is the JSON form of a value. Digits are written from most to
+// least significant.
+type jsonZ struct {
+	Version  int   `json:"version"`
+	Modulus  int   `json:"modulus"`
+	Negative bool  `json:"negative,omitempty"`
+	Digits   []int `json:"digits"`
+}
+
+// MarshalBinary encodes x as a version byte followed by the modulus, the
+// sign, the number of digits, and the digits from least to most
+// significant, each as an unsigned varint.
+func (x *Z) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 1, 3+(len(x.value)+2)*binary.MaxVarintLen64)
+	b[0] = encodingVersion
+	b = appendUvarint(b, uint64(x.modulus))
+	if x.negative {
+		b = append(b, 1)
+	} else {
+		b = append(b, 0)
+	}
+
+	b = appendUvarint(b, uint64(len(x.value)))
+	for _, v := range x.value {
+		b = appendUvarint(b, uint64(v))
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary decodes data written by MarshalBinary into x.
+func (x *Z) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("%w: empty data", ErrSyntax)
+	}
+
+	if data[0] == 0 || encodingVersion < data[0] {
+		return fmt.Errorf("%w: %d", ErrVersion, data[0])
+	}
+
+	d := decoder{data: data[1:]}
+	modulus := d.uvarint()
+	negative := d.byte()
+	digits := make([]int, 0)
+	for n := d.uvarint(); d.err == nil && 0 < n; n-- {
+		digits = append(digits, int(d.uvarint()))
+	}
+
+	if d.err != nil {
+		return d.err
+	}
+
+	if len(d.data) != 0 || 1 < negative {
+		return fmt.Errorf("%w: malformed data", ErrSyntax)
+	}
+
+	return x.set(int(modulus), digits, negative == 1)
+}
+
+// MarshalJSON encodes x as an object holding the encoding version, the
+// modulus, the sign, and the digits.
+func (x *Z) MarshalJSON() ([]byte, error) {
+	jz := jsonZ{
+		Version:  encodingVersion,
+		Modulus:  x.modulus,
+		Negative: x.negative,
+		Digits:   make([]int, 0, len(x.value)),
+	}
+
+	for i := len(x.value) - 1; 0 <= i; i-- {
+		jz.Digits = append(jz.Digits, x.value[i])
+	}
+
+	return json.Marshal(jz)
+}
+
+// UnmarshalJSON decodes data written by MarshalJSON into x.
+func (x *Z) UnmarshalJSON(data []byte) error {
+	var jz jsonZ
+	if err := json.Unmarshal(data, &jz); err != nil {
+		return err
+	}
+
+	if jz.Version < 1 || encodingVersion < jz.Version {
+		return fmt.Errorf("%w: %d", ErrVersion, jz.Version)
+	}
+
+	digits := make([]int, len(jz.Digits))
+	for i, v := range jz.Digits {
+		digits[len(digits)-1-i] = v
+	}
+
+	return x.set(jz.Modulus, digits, jz.Negative)
+}
+
+// MarshalText encodes x in the form returned by String.
+func (x *Z) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText decodes text in the form returned by String into x.
+func (x *Z) UnmarshalText(text []byte) error {
+	y, err := parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*x = *y
+	return nil
+}
+
+// parse returns the value written as by String, such as "-(1,2) (base 3)".
+// Zero is written as "(0) base (n)", though "(0) (base n)" is also
+// accepted.
+func parse(s string) (*Z, error) {
+	t := s
+	negative := strings.HasPrefix(t, "-")
+	if negative {
+		t = t[1:]
+	}
+
+	if !strings.HasPrefix(t, "(") {
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+
+	end := strings.IndexByte(t, ')')
+	if end < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+
+	fields := strings.Split(t[1:end], ",")
+	digits := make([]int, len(fields))
+	for i, f := range fields {
+		v, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: digit %q", ErrSyntax, s, f)
+		}
+
+		digits[len(digits)-1-i] = v
+	}
+
+	t = t[end+1:]
+	switch {
+	case strings.HasPrefix(t, " (base ") && strings.HasSuffix(t, ")"):
+		t = t[len(" (base ") : len(t)-1]
+	case strings.HasPrefix(t, " base (") && strings.HasSuffix(t, ")"):
+		t = t[len(" base (") : len(t)-1]
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+
+	modulus, err := strconv.Atoi(t)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: modulus %q", ErrSyntax, s, t)
+	}
+
+	x := &Z{}
+	if err := x.set(modulus, digits, negative); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+// set sets x to the value of the given digits, from least to most
+// significant, in base modulus. Each digit must lie in [0,modulus).
+func (x *Z) set(modulus int, digits []int, negative bool) error {
+	if modulus < 2 {
+		return fmt.Errorf("%w: %d", ErrInvalidModulus, modulus)
+	}
+
+	for _, v := range digits {
+		if v < 0 || modulus <= v {
+			return fmt.Errorf("%w: digit %d in base %d", ErrSyntax, v, modulus)
+		}
+	}
+
+	*x = Z{value: digits, modulus: modulus, negative: negative}
+	x.clean()
+	return nil
+}
+
+// appendUvarint appends the varint encoding of v to b.
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+// decoder reads varints from data, recording the first error.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+// byte reads a single byte.
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+
+	if len(d.data) == 0 {
+		d.err = fmt.Errorf("%w: truncated data", ErrSyntax)
+		return 0
+	}
+
+	c := d.data[0]
+	d.data = d.data[1:]
+	return c
+}
+
+// uvarint reads an unsigned varint that fits in an int.
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 || uint64(int(^uint(0)>>1)) < v {
+		d.err = fmt.Errorf("%w: malformed varint", ErrSyntax)
+		return 0
+	}
+
+	d.data = d.data[n:]
+	return v
+}
@@ -0,0 +1,110 @@
+package zmodn
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestEncoding(t *testing.T) {
+	tests := []*Z{
+		New(0, 10),
+		New(1234, 10),
+		New(-16, 3),
+		New(255, 16),
+	}
+
+	for _, x := range tests {
+		b, err := x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var y Z
+		if err := y.UnmarshalBinary(b); err != nil {
+			t.Fatal(err)
+		}
+
+		if x.String() != y.String() {
+			t.Fatalf("\nexpected %v\nreceived %v\n", x, &y)
+		}
+
+		if b, err = json.Marshal(x); err != nil {
+			t.Fatal(err)
+		}
+
+		y = Z{}
+		if err := json.Unmarshal(b, &y); err != nil {
+			t.Fatal(err)
+		}
+
+		if x.String() != y.String() {
+			t.Fatalf("\nexpected %v\nreceived %v\n", x, &y)
+		}
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(x); err != nil {
+			t.Fatal(err)
+		}
+
+		y = Z{}
+		if err := gob.NewDecoder(&buf).Decode(&y); err != nil {
+			t.Fatal(err)
+		}
+
+		if x.String() != y.String() {
+			t.Fatalf("\nexpected %v\nreceived %v\n", x, &y)
+		}
+
+		if b, err = x.MarshalText(); err != nil {
+			t.Fatal(err)
+		}
+
+		y = Z{}
+		if err := y.UnmarshalText(b); err != nil {
+			t.Fatal(err)
+		}
+
+		if x.String() != y.String() {
+			t.Fatalf("\nexpected %v\nreceived %v\n", x, &y)
+		}
+	}
+
+	if s := New(0, 10).String(); s != "(0) base (10)" {
+		t.Fatalf("\nexpected %q\nreceived %q\n", "(0) base (10)", s)
+	}
+
+	b, _ := json.Marshal(New(-12, 10))
+	if exp := `{"version":1,"modulus":10,"negative":true,"digits":[1,2]}`; string(b) != exp {
+		t.Fatalf("\nexpected %s\nreceived %s\n", exp, b)
+	}
+
+	errTests := []struct {
+		text string
+		err  error
+	}{
+		{text: "(0) (base 10)"},
+		{text: "(1,10) (base 10)", err: ErrSyntax},
+		{text: "(1,2) (base 1)", err: ErrInvalidModulus},
+		{text: "(1,2) base 10", err: ErrSyntax},
+		{text: "1,2 (base 10)", err: ErrSyntax},
+	}
+
+	for _, test := range errTests {
+		var x Z
+		if err := x.UnmarshalText([]byte(test.text)); !errors.Is(err, test.err) {
+			t.Fatalf("\n%q: expected %v\nreceived %v\n", test.text, test.err, err)
+		}
+	}
+
+	var x Z
+	if err := x.UnmarshalBinary([]byte{2, 10, 0, 0}); !errors.Is(err, ErrVersion) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrVersion, err)
+	}
+
+	if err := x.UnmarshalBinary([]byte{1, 10, 0, 2, 1}); !errors.Is(err, ErrSyntax) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrSyntax, err)
+	}
+}
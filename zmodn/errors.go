@@ -0,0 +1,53 @@
+package zmodn
+
+import (
+	"errors"
+	"strconv"
+)
+
+var (
+	// ErrDivisionByZero is returned when dividing by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+
+	// ErrInvalidAlphabet is returned when an alphabet does not have a
+	// distinct rune for each digit of a modulus.
+	ErrInvalidAlphabet = errors.New("invalid alphabet")
+
+	// ErrInvalidModulus is returned when a modulus is less than two.
+	ErrInvalidModulus = errors.New("invalid modulus")
+
+	// ErrModulusMismatch is returned when values of differing moduli are
+	// used together.
+	ErrModulusMismatch = errors.New("modulus mismatch")
+
+	// ErrSyntax is returned when text or encoded data does not have the
+	// form of a value.
+	ErrSyntax = errors.New("invalid syntax")
+
+	// ErrVersion is returned when encoded data was written by an
+	// unsupported version of the encoding.
+	ErrVersion = errors.New("unsupported encoding version")
+)
+
+// ModulusError describes two values whose moduli differ.
+type ModulusError struct {
+	X, Y int
+}
+
+func (e *ModulusError) Error() string {
+	return ErrModulusMismatch.Error() + ": " + strconv.Itoa(e.X) + " != " + strconv.Itoa(e.Y)
+}
+
+// Unwrap returns ErrModulusMismatch.
+func (e *ModulusError) Unwrap() error {
+	return ErrModulusMismatch
+}
+
+// checkModuli returns a ModulusError if x and y have differing moduli.
+func checkModuli(x, y *Z) error {
+	if x.modulus != y.modulus {
+		return &ModulusError{X: x.modulus, Y: y.modulus}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package zmodn
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -16,6 +17,25 @@ type Z struct {
 
 // New ...
 func New(value int, modulus int) *Z {
+	x, err := TryNew(value, modulus)
+	if err != nil {
+		panic(err)
+	}
+
+	return x
+}
+
+// TryNew returns value in base modulus. The modulus must be at least two.
+func TryNew(value int, modulus int) (*Z, error) {
+	if modulus < 2 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidModulus, modulus)
+	}
+
+	return newZ(value, modulus), nil
+}
+
+// newZ returns value in base modulus without validating the modulus.
+func newZ(value int, modulus int) *Z {
 	if value < 0 {
 		return &Z{value: math.Base(-value, modulus), modulus: modulus, negative: true}
 	}
@@ -42,101 +62,47 @@ func (x *Z) Abs() *Z {
 
 // Add y to x.
 func (x *Z) Add(y *Z) {
-	n := x.modulus
-	if n != y.modulus {
-		panic("")
-	}
-
-	switch {
-	case x.negative:
-		if !y.negative {
-			x.Subtract(y)
-			return
-		}
-	default:
-		if y.negative {
-			// return Subtract(x, y.Abs())
-			x.Subtract(y)
-			return
-		}
-	}
-
-	var (
-		xLen, yLen     = len(x.value), len(y.value)
-		minLen         = math.MinInt(xLen, yLen)
-		v0, v1, k0, k1 int
-	)
-
-	for i := 0; i < minLen; i++ {
-		v0, k0 = addWithCarry(x.value[i], y.value[i], n)
-		v1, k1 = addWithCarry(v0, k1, n)
-		x.value[i] = v1
-		k1 += k0
-	}
-
-	switch minLen {
-	case xLen:
-		for i := minLen; i < yLen; i++ {
-			v1, k1 = addWithCarry(y.value[i], k1, n)
-			x.value[i] = v1
-		}
-	case yLen:
-		for i := minLen; i < xLen; i++ {
-			v1, k1 = addWithCarry(x.value[i], k1, n)
-			x.value[i] = v1
-		}
+	if err := checkModuli(x, y); err != nil {
+		panic(err)
 	}
 
-	// x.negative = x.negative && y.negative
-	x.clean()
+	*x = *add(x, y)
 }
 
 // Add ...
 func Add(x, y *Z) *Z {
-	n := x.modulus
-	if n != y.modulus {
-		panic("")
+	z, err := TryAdd(x, y)
+	if err != nil {
+		panic(err)
 	}
 
-	switch {
-	case x.negative:
-		if !y.negative {
-			return Subtract(y, x.Abs())
-		}
-	default:
-		if y.negative {
-			return Subtract(x, y.Abs())
-		}
+	return z
+}
+
+// TryAdd returns x+y, or an error if x and y have differing moduli.
+func TryAdd(x, y *Z) (*Z, error) {
+	if err := checkModuli(x, y); err != nil {
+		return nil, err
 	}
 
-	var (
-		z              = New(0, n)
-		xLen, yLen     = len(x.value), len(y.value)
-		minLen         = math.MinInt(xLen, yLen)
-		v0, v1, k0, k1 int
-	)
+	return add(x, y), nil
+}
 
-	for i := 0; i < minLen; i++ {
-		v0, k0 = addWithCarry(x.value[i], y.value[i], n)
-		v1, k1 = addWithCarry(v0, k1, n)
-		z.value = append(z.value, v1)
-		k1 += k0
+// add returns x+y. The moduli of x and y must agree.
+func add(x, y *Z) *Z {
+	if x.negative != y.negative {
+		// Flip the sign of y directly, since Negate keeps zero non-negative.
+		y = y.Copy()
+		y.negative = x.negative
+		return subtract(x, y)
 	}
 
-	switch minLen {
-	case xLen:
-		for i := minLen; i < yLen; i++ {
-			v1, k1 = addWithCarry(y.value[i], k1, n)
-			z.value = append(z.value, v1)
-		}
-	case yLen:
-		for i := minLen; i < xLen; i++ {
-			v1, k1 = addWithCarry(x.value[i], k1, n)
-			z.value = append(z.value, v1)
-		}
+	z := &Z{
+		value:    addDigits(x.value, y.value, x.modulus),
+		modulus:  x.modulus,
+		negative: x.negative,
 	}
 
-	z.negative = x.negative && y.negative
 	z.clean()
 	return z
 }
@@ -145,18 +111,28 @@ func Add(x, y *Z) *Z {
 func (x *Z) clean() {
 	x.trim()
 	x.normalize()
+	if len(x.value) == 0 {
+		x.negative = false
+	}
 }
 
-// Compare ...
+// Compare returns -1, 0, or 1 as x is less than, equal to, or greater
+// than y. Values of differing moduli are compared by their integer
+// values.
 func (x *Z) Compare(y *Z) int {
-	xInt, yInt := x.Integer(), y.Integer()
-	switch {
-	case xInt < yInt:
+	if x.modulus != y.modulus {
+		return x.BigInt().Cmp(y.BigInt())
+	}
+
+	switch xs, ys := x.Sign(), y.Sign(); {
+	case xs < ys:
 		return -1
-	case yInt < xInt:
+	case ys < xs:
 		return 1
+	case xs < 0:
+		return compareDigits(y.value, x.value)
 	default:
-		return 0
+		return compareDigits(x.value, y.value)
 	}
 }
 
@@ -172,7 +148,8 @@ func (x *Z) Copy() *Z {
 	return &cpy
 }
 
-// Integer ...
+// Integer returns x as an int. The result overflows if x does not fit in
+// an int; use BigInt instead.
 func (x *Z) Integer() int {
 	n := math.Base10(x.value, x.modulus)
 	if x.negative {
@@ -187,9 +164,9 @@ func (x *Z) IsEven() bool {
 	return len(x.value) == 0 || x.value[0]%2 == 0
 }
 
-// IsNegative ...
+// IsNegative reports whether x is less than zero.
 func (x *Z) IsNegative() bool {
-	return x.negative
+	return x.Sign() < 0
 }
 
 // IsOdd ...
@@ -197,43 +174,68 @@ func (x *Z) IsOdd() bool {
 	return len(x.value) != 0 && x.value[0]%2 != 0
 }
 
-// IsPositive ...
+// IsPositive reports whether x is greater than zero.
 func (x *Z) IsPositive() bool {
-	return !x.negative
+	return 0 < x.Sign()
 }
 
-// IsZero ...
+// IsZero reports whether x is zero.
 func (x *Z) IsZero() bool {
-	return x.Integer() == 0
+	return len(x.value) == 0
 }
 
-// Mulitply ...
+// Mul multiplies x by y.
+func (x *Z) Mul(y *Z) {
+	if err := checkModuli(x, y); err != nil {
+		panic(err)
+	}
+
+	*x = *multiply(x, y)
+}
+
+// Mulitply returns xy.
+//
+// Deprecated: Use Multiply.
 func (x *Z) Mulitply(y *Z) *Z {
-	var (
-		yInt       = y.Integer()
-		isNegative = yInt < 0
-	)
+	return Multiply(x, y)
+}
 
-	if isNegative {
-		yInt *= -1
+// Multiply returns xy. It panics if x and y have differing moduli.
+func Multiply(x, y *Z) *Z {
+	z, err := TryMultiply(x, y)
+	if err != nil {
+		panic(err)
 	}
 
-	z := x.Copy()
-	for ; 1 < yInt; yInt-- {
-		z = Add(z, x)
+	return z
+}
+
+// TryMultiply returns xy, or an error if x and y have differing moduli.
+func TryMultiply(x, y *Z) (*Z, error) {
+	if err := checkModuli(x, y); err != nil {
+		return nil, err
 	}
 
-	if isNegative {
-		z = z.Negate()
+	return multiply(x, y), nil
+}
+
+// multiply returns xy. The moduli of x and y must agree. Factors of more
+// than karatsubaThreshold digits are multiplied by Karatsuba's method.
+func multiply(x, y *Z) *Z {
+	z := &Z{
+		value:    multiplyDigits(x.value, y.value, x.modulus),
+		modulus:  x.modulus,
+		negative: x.negative != y.negative,
 	}
 
+	z.clean()
 	return z
 }
 
-// Negate ...
+// Negate returns -x.
 func (x *Z) Negate() *Z {
 	y := x.Copy()
-	y.negative = !y.negative
+	y.negative = !y.negative && len(y.value) != 0
 	return y
 }
 
@@ -269,114 +271,65 @@ func (x *Z) String() string {
 	return b.String()
 }
 
-// Subtract y from x.
-func (x *Z) Subtract(y *Z) {
-	n := x.modulus
-	if n != y.modulus {
-		panic("")
-	}
-
+// Sign returns -1, 0, or 1 as x is negative, zero, or positive.
+func (x *Z) Sign() int {
 	switch {
+	case len(x.value) == 0:
+		return 0
 	case x.negative:
-		if !y.negative {
-			// return Add(y, x.Abs())
-		}
+		return -1
 	default:
-		if y.negative {
-			// return Add(x, y.Abs())
-		}
-	}
-
-	var isNegative bool
-	if x.Compare(y) < 0 {
-		x, y = y, x
-		isNegative = true
-	}
-
-	var (
-		xLen, yLen     = len(x.value), len(y.value)
-		minLen         = math.MinInt(xLen, yLen)
-		v0, v1, k0, k1 int
-	)
-
-	for i := 0; i < minLen; i++ {
-		v0, k0 = subtractWithBorrow(x.value[i], k1, n)
-		v1, k1 = subtractWithBorrow(v0, y.value[i], n)
-		x.value[i] = v1
-		k1 += k0
+		return 1
 	}
+}
 
-	switch minLen {
-	case xLen:
-		for i := minLen; i < yLen; i++ {
-			v1, k1 = subtractWithBorrow(y.value[i], k1, n)
-			x.value[i] = v1
-		}
-	case yLen:
-		// Should always be this case...
-		for i := minLen; i < xLen; i++ {
-			v1, k1 = subtractWithBorrow(x.value[i], k1, n)
-			x.value[i] = v1
-		}
+// Subtract y from x.
+func (x *Z) Subtract(y *Z) {
+	if err := checkModuli(x, y); err != nil {
+		panic(err)
 	}
 
-	x.negative = isNegative || (x.negative && y.negative)
-	x.clean()
+	*x = *subtract(x, y)
 }
 
 // Subtract ...
 func Subtract(x, y *Z) *Z {
-	n := x.modulus
-	if n != y.modulus {
-		panic("")
+	z, err := TrySubtract(x, y)
+	if err != nil {
+		panic(err)
 	}
 
-	switch {
-	case x.negative:
-		if !y.negative {
-			return Add(y, x.Abs())
-		}
-	default:
-		if y.negative {
-			return Add(x, y.Abs())
-		}
-	}
+	return z
+}
 
-	var isNegative bool
-	if x.Compare(y) < 0 {
-		x, y = y, x
-		isNegative = true
+// TrySubtract returns x-y, or an error if x and y have differing moduli.
+func TrySubtract(x, y *Z) (*Z, error) {
+	if err := checkModuli(x, y); err != nil {
+		return nil, err
 	}
 
-	var (
-		z              = New(0, n)
-		xLen, yLen     = len(x.value), len(y.value)
-		minLen         = math.MinInt(xLen, yLen)
-		v0, v1, k0, k1 int
-	)
+	return subtract(x, y), nil
+}
 
-	for i := 0; i < minLen; i++ {
-		v0, k0 = subtractWithBorrow(x.value[i], k1, n)
-		v1, k1 = subtractWithBorrow(v0, y.value[i], n)
-		z.value = append(z.value, v1)
-		k1 += k0
+// subtract returns x-y. The moduli of x and y must agree.
+func subtract(x, y *Z) *Z {
+	if x.negative != y.negative {
+		// Flip the sign of y directly, since Negate keeps zero non-negative.
+		y = y.Copy()
+		y.negative = x.negative
+		return add(x, y)
 	}
 
-	switch minLen {
-	case xLen:
-		for i := minLen; i < yLen; i++ {
-			v1, k1 = subtractWithBorrow(y.value[i], k1, n)
-			z.value = append(z.value, v1)
-		}
-	case yLen:
-		// Should always be this case...
-		for i := minLen; i < xLen; i++ {
-			v1, k1 = subtractWithBorrow(x.value[i], k1, n)
-			z.value = append(z.value, v1)
-		}
+	// Both have the sign of x, so x-y has the sign of x if |y| <= |x| and
+	// the opposite sign otherwise.
+	z := &Z{modulus: x.modulus, negative: x.negative}
+	if compareDigits(x.value, y.value) < 0 {
+		z.value = subtractDigits(y.value, x.value, x.modulus)
+		z.negative = !x.negative
+	} else {
+		z.value = subtractDigits(x.value, y.value, x.modulus)
 	}
 
-	z.negative = isNegative || (x.negative && y.negative)
 	z.clean()
 	return z
 }
@@ -1,9 +1,10 @@
 package zmodn
 
 import (
+	"errors"
+	"math/big"
+	"math/rand"
 	"testing"
-
-	math "github.com/nathangreene3/math"
 )
 
 func TestField(t *testing.T) {
@@ -92,12 +93,6 @@ func newIncDec(base int, incOrd []int) (incrementor, decrementor) {
 		panic("")
 	}
 
-	var (
-		n        = len(incOrd)
-		p        = 1
-		basePows = math.BasePows(n, base)
-	)
-
 	var (
 		c int
 		f = func(n int) int {
@@ -117,3 +112,114 @@ func newIncDec(base int, incOrd []int) (incrementor, decrementor) {
 func TestNewIncDec(t *testing.T) {
 
 }
+
+func TestTryArithmetic(t *testing.T) {
+	if _, err := TryNew(5, 1); !errors.Is(err, ErrInvalidModulus) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidModulus, err)
+	}
+
+	x, y := New(5, 10), New(5, 3)
+	for _, f := range []func(x, y *Z) (*Z, error){TryAdd, TrySubtract, TryMultiply} {
+		_, err := f(x, y)
+		if !errors.Is(err, ErrModulusMismatch) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", ErrModulusMismatch, err)
+		}
+
+		var me *ModulusError
+		if !errors.As(err, &me) || me.X != 10 || me.Y != 3 {
+			t.Fatalf("\nexpected moduli (10,3)\nreceived %v\n", err)
+		}
+	}
+
+	z, err := TryAdd(x, New(3, 10))
+	if err != nil || z.Integer() != 8 {
+		t.Fatalf("\nexpected 8\nreceived %v, %v\n", z, err)
+	}
+}
+
+func TestMultiply(t *testing.T) {
+	tests := []struct {
+		n, x, y, exp int
+	}{
+		{n: 10, x: 0, y: 7, exp: 0},
+		{n: 10, x: -3, y: 0, exp: 0},
+		{n: 10, x: 5, y: 7, exp: 35},
+		{n: 10, x: -5, y: 7, exp: -35},
+		{n: 10, x: -5, y: -7, exp: 35},
+		{n: 2, x: 13, y: 11, exp: 143},
+		{n: 3, x: 16, y: -8, exp: -128},
+		{n: 16, x: 255, y: 255, exp: 65025},
+	}
+
+	for _, test := range tests {
+		x, y := New(test.x, test.n), New(test.y, test.n)
+		if rec := Multiply(x, y); rec.Integer() != test.exp || rec.IsNegative() != (test.exp < 0) {
+			t.Fatalf("\nexpected %d\nreceived %v\n", test.exp, rec)
+		}
+
+		x.Mul(y)
+		if x.Integer() != test.exp {
+			t.Fatalf("\nexpected %d\nreceived %v\n", test.exp, x)
+		}
+	}
+
+	if rec := Add(New(5, 10), New(7, 10)); rec.Integer() != 12 {
+		t.Fatalf("\nexpected 12\nreceived %v\n", rec)
+	}
+}
+
+func TestKaratsuba(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 10, 256} {
+		for _, size := range []int{karatsubaThreshold + 1, 3 * karatsubaThreshold, 200} {
+			a, b := make([]int, size), make([]int, size-size/3)
+			for i := range a {
+				a[i] = r.Intn(n)
+			}
+
+			for i := range b {
+				b[i] = r.Intn(n)
+			}
+
+			a, b = trimDigits(a), trimDigits(b)
+			if exp, rec := longMultiplyDigits(a, b, n), multiplyDigits(a, b, n); !equalDigits(exp, rec) {
+				t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+			}
+
+			exp := new(big.Int).Mul(bigDigits(a, n), bigDigits(b, n))
+			if rec := bigDigits(multiplyDigits(a, b, n), n); exp.Cmp(rec) != 0 {
+				t.Fatalf("\nexpected %v\nreceived %v\n", exp, rec)
+			}
+		}
+	}
+}
+
+// bigDigits returns the value of a magnitude in base n.
+func bigDigits(a []int, n int) *big.Int {
+	var (
+		z = new(big.Int)
+		m = big.NewInt(int64(n))
+	)
+
+	for i := len(a) - 1; 0 <= i; i-- {
+		z.Mul(z, m)
+		z.Add(z, big.NewInt(int64(a[i])))
+	}
+
+	return z
+}
+
+// equalDigits reports whether two magnitudes are equal.
+func equalDigits(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,266 @@
+package zmodn
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// UpperDigits are the digits 0-9 followed by the letters A-Z, for
+	// moduli up to 36.
+	UpperDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	// LowerDigits are the digits 0-9 followed by the letters a-z, for
+	// moduli up to 36.
+	LowerDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+)
+
+// Parse returns the value of s in base modulus. The text may be in the
+// form returned by String, whose base must be the modulus, or a string of
+// digits from UpperDigits, in either case, with an optional sign.
+func Parse(s string, modulus int) (*Z, error) {
+	if strings.HasPrefix(strings.TrimPrefix(s, "-"), "(") {
+		x, err := parse(s)
+		if err != nil {
+			return nil, err
+		}
+
+		if x.modulus != modulus {
+			return nil, &ModulusError{X: modulus, Y: x.modulus}
+		}
+
+		return x, nil
+	}
+
+	digits, err := digitMap(UpperDigits, modulus)
+	if err != nil {
+		return nil, err
+	}
+
+	for r, d := range digits {
+		if l := unicode.ToLower(r); l != r {
+			digits[l] = d
+		}
+	}
+
+	return parseDigits(s, modulus, digits)
+}
+
+// ParseAlphabet returns the value of s in base modulus, where the ith
+// rune of the alphabet is the digit i. The digits are written from most
+// to least significant with an optional sign. The alphabet must have a
+// distinct rune for each digit and may not contain a sign.
+func ParseAlphabet(s string, modulus int, alphabet string) (*Z, error) {
+	digits, err := digitMap(alphabet, modulus)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDigits(s, modulus, digits)
+}
+
+// parseDigits returns the value of s in base modulus, given the digit of
+// each rune.
+func parseDigits(s string, modulus int, digits map[rune]int) (*Z, error) {
+	t := s
+	negative := strings.HasPrefix(t, "-")
+	if negative || strings.HasPrefix(t, "+") {
+		t = t[1:]
+	}
+
+	if t == "" {
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+
+	value := make([]int, 0, utf8.RuneCountInString(t))
+	for _, r := range t {
+		d, ok := digits[r]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q: digit %q in base %d", ErrSyntax, s, r, modulus)
+		}
+
+		value = append(value, d)
+	}
+
+	for i, j := 0, len(value)-1; i < j; i, j = i+1, j-1 {
+		value[i], value[j] = value[j], value[i]
+	}
+
+	x := &Z{}
+	if err := x.set(modulus, value, negative); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+// Format returns the digits of x from most to least significant, where
+// the ith rune of the alphabet is the digit i, preceded by a minus sign
+// if x is negative. The digits are padded to at least width runes with
+// pad. Padding with the zero digit follows the sign; other padding
+// precedes it. It panics if the alphabet does not have a distinct rune
+// for each digit.
+func Format(x *Z, alphabet string, width int, pad rune) string {
+	s, err := TryFormat(x, alphabet, width, pad)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// TryFormat returns x formatted as by Format, or an error if the
+// alphabet does not have a distinct rune for each digit.
+func TryFormat(x *Z, alphabet string, width int, pad rune) (string, error) {
+	if _, err := digitMap(alphabet, x.modulus); err != nil {
+		return "", err
+	}
+
+	var (
+		runes  = []rune(alphabet)
+		digits = x.text(runes)
+		sign   string
+	)
+
+	if x.negative {
+		sign = "-"
+	}
+
+	n := width - utf8.RuneCountInString(sign+digits)
+	if n <= 0 {
+		return sign + digits, nil
+	}
+
+	padding := strings.Repeat(string(pad), n)
+	if pad == runes[0] {
+		return sign + padding + digits, nil
+	}
+
+	return padding + sign + digits, nil
+}
+
+// Format implements fmt.Formatter. The verbs b, o, d, x, and X write x in
+// base 2, 8, 10, or 16 with the flags '+', ' ', '#', '-', and '0', a
+// width, and a precision giving the minimum number of digits, as for
+// integers. The verbs v and s write the form returned by String.
+func (x *Z) Format(s fmt.State, verb rune) {
+	if x == nil {
+		fmt.Fprintf(s, fmt.FormatString(s, 's'), "<nil>")
+		return
+	}
+
+	var (
+		base     int
+		alphabet = LowerDigits
+		prefix   string
+	)
+
+	switch verb {
+	case 'b':
+		base, prefix = 2, "0b"
+	case 'o':
+		base, prefix = 8, "0"
+	case 'd':
+		base = 10
+	case 'x':
+		base, prefix = 16, "0x"
+	case 'X':
+		base, prefix, alphabet = 16, "0X", UpperDigits
+	case 'v', 's':
+		fmt.Fprintf(s, fmt.FormatString(s, 's'), x.String())
+		return
+	default:
+		fmt.Fprintf(s, "%%!%c(zmodn.Z=%s)", verb, x.String())
+		return
+	}
+
+	var sign string
+	switch {
+	case x.IsNegative():
+		sign = "-"
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	var (
+		digits = x.toBase(base).text([]rune(alphabet))
+		w, wok = s.Width()
+		p, pok = s.Precision()
+	)
+
+	switch {
+	case pok && p == 0 && x.IsZero():
+		digits = ""
+	case !pok && wok && s.Flag('0') && !s.Flag('-'):
+		// As for integers, zero padding fills the width less the sign,
+		// even if a prefix follows it.
+		p = w - len(sign)
+	}
+
+	if n := p - len(digits); 0 < n {
+		digits = strings.Repeat("0", n) + digits
+	}
+
+	if !s.Flag('#') || verb == 'o' && strings.HasPrefix(digits, "0") {
+		prefix = ""
+	}
+
+	text := sign + prefix + digits
+	switch n := w - len(text); {
+	case !wok || n <= 0:
+	case s.Flag('-'):
+		text += strings.Repeat(" ", n)
+	default:
+		text = strings.Repeat(" ", n) + text
+	}
+
+	fmt.Fprint(s, text)
+}
+
+// text returns the digits of x from most to least significant, where the
+// ith rune of the alphabet is the digit i. Zero is written as the zero
+// digit.
+func (x *Z) text(alphabet []rune) string {
+	if len(x.value) == 0 {
+		return string(alphabet[0])
+	}
+
+	var b strings.Builder
+	for i := len(x.value) - 1; 0 <= i; i-- {
+		b.WriteRune(alphabet[x.value[i]])
+	}
+
+	return b.String()
+}
+
+// digitMap returns the digit of each of the first modulus runes of the
+// alphabet, or an error if the alphabet is too short or they are not
+// distinct.
+func digitMap(alphabet string, modulus int) (map[rune]int, error) {
+	if modulus < 2 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidModulus, modulus)
+	}
+
+	digits := make(map[rune]int, modulus)
+	for _, r := range alphabet {
+		if len(digits) == modulus {
+			break
+		}
+
+		if _, ok := digits[r]; ok || r == '-' || r == '+' {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidAlphabet, alphabet)
+		}
+
+		digits[r] = len(digits)
+	}
+
+	if len(digits) < modulus {
+		return nil, fmt.Errorf("%w: %q has fewer than %d digits", ErrInvalidAlphabet, alphabet, modulus)
+	}
+
+	return digits, nil
+}
@@ -0,0 +1,109 @@
+package zmodn
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s       string
+		modulus int
+		exp     int
+		err     error
+	}{
+		{s: "0", modulus: 10, exp: 0},
+		{s: "-42", modulus: 10, exp: -42},
+		{s: "+ff", modulus: 16, exp: 255},
+		{s: "FF", modulus: 16, exp: 255},
+		{s: "zz", modulus: 36, exp: 1295},
+		{s: "-(1,2,0) (base 3)", modulus: 3, exp: -15},
+		{s: "(0) (base 7)", modulus: 7, exp: 0},
+		{s: "(1,2,0) (base 3)", modulus: 10, err: ErrModulusMismatch},
+		{s: "12", modulus: 2, err: ErrSyntax},
+		{s: "-", modulus: 10, err: ErrSyntax},
+		{s: "", modulus: 10, err: ErrSyntax},
+		{s: "1", modulus: 37, err: ErrInvalidAlphabet},
+		{s: "1", modulus: 1, err: ErrInvalidModulus},
+	}
+
+	for _, test := range tests {
+		x, err := Parse(test.s, test.modulus)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("\nexpected %v\nreceived %v\n", test.err, err)
+		}
+
+		if err == nil && (x.Integer() != test.exp || x.modulus != test.modulus) {
+			t.Fatalf("\nexpected %d (base %d)\nreceived %v\n", test.exp, test.modulus, x)
+		}
+	}
+
+	x, err := ParseAlphabet("-bca", 3, "abc")
+	if err != nil || x.Integer() != -15 {
+		t.Fatalf("\nexpected -15\nreceived %v, %v\n", x, err)
+	}
+
+	if _, err := ParseAlphabet("ab", 3, "aab"); !errors.Is(err, ErrInvalidAlphabet) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidAlphabet, err)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		x        *Z
+		alphabet string
+		width    int
+		pad      rune
+		exp      string
+	}{
+		{x: New(255, 16), alphabet: LowerDigits, exp: "ff"},
+		{x: New(-255, 16), alphabet: UpperDigits, width: 6, pad: '0', exp: "-000FF"},
+		{x: New(-255, 16), alphabet: UpperDigits, width: 6, pad: ' ', exp: "   -FF"},
+		{x: New(0, 3), alphabet: "abc", width: 3, pad: 'a', exp: "aaa"},
+		{x: New(15, 3), alphabet: "abc", width: 2, pad: 'a', exp: "bca"},
+		{x: New(5, 2), alphabet: "○●", exp: "●○●"},
+	}
+
+	for _, test := range tests {
+		if rec := Format(test.x, test.alphabet, test.width, test.pad); rec != test.exp {
+			t.Fatalf("\nexpected %q\nreceived %q\n", test.exp, rec)
+		}
+
+		x, err := ParseAlphabet(test.exp, test.x.modulus, test.alphabet)
+		if test.pad == []rune(test.alphabet)[0] && (err != nil || x.Compare(test.x) != 0) {
+			t.Fatalf("\nexpected %v\nreceived %v, %v\n", test.x, x, err)
+		}
+	}
+
+	if _, err := TryFormat(New(5, 10), "012", 0, '0'); !errors.Is(err, ErrInvalidAlphabet) {
+		t.Fatalf("\nexpected %v\nreceived %v\n", ErrInvalidAlphabet, err)
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	formats := []string{"%b", "%o", "%d", "%x", "%X", "%#b", "%#o", "%#x", "%#X", "%+d", "% d", "%8d", "%-8d|", "%08d", "%#08x", "%.5d", "%8.5x", "%.0d"}
+	for _, n := range []int{0, 1, -1, 42, -255, 123456789} {
+		for _, base := range []int{3, 10, 36} {
+			x := New(n, base)
+			for _, f := range formats {
+				if exp, rec := fmt.Sprintf(f, n), fmt.Sprintf(f, x); exp != rec {
+					t.Fatalf("\n%s: expected %q\nreceived %q\n", f, exp, rec)
+				}
+			}
+		}
+	}
+
+	x := New(-15, 3)
+	if exp, rec := "[-(1,2,0) (base 3)]", fmt.Sprintf("[%v]", x); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+
+	if exp, rec := "%!c(zmodn.Z=(0) base (2))", fmt.Sprintf("%c", Zero(2)); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+
+	if exp, rec := "<nil>", fmt.Sprintf("%x", (*Z)(nil)); exp != rec {
+		t.Fatalf("\nexpected %q\nreceived %q\n", exp, rec)
+	}
+}
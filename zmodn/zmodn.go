@@ -40,7 +40,7 @@ func subtractWithBorrow(a, b, modulus int) (int, int) {
 // In either case, k = (x-r)/n.
 func euclidsCoeffs(x, modulus int) (k int, r int) {
 	if modulus == 0 {
-		panic("")
+		panic("modulus must be non-zero")
 	}
 
 	r = (x%modulus + modulus) % modulus
@@ -1,7 +1,6 @@
 package zmodn
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/nathangreene3/math"
@@ -284,6 +283,7 @@ func TestSubtract(t *testing.T) {
 	n := 3
 	x, y := math.Base(16, n), math.Base(8, n) // 121 - 22 = 22
 	z, k := subtractWithBorrow(x[0], y[0], n) // 1-2 = 2, borrow 1
-	fmt.Println(z, k)
-	t.Fatal()
+	if z != 2 || k != 1 {
+		t.Fatalf("\nexpected (2,1)\nreceived (%d,%d)\n", z, k)
+	}
 }